@@ -118,3 +118,15 @@ type TypeWithMarkdownDescription interface {
 	// Attribute.
 	MarkdownDescription(context.Context) string
 }
+
+// TypeWithValueCopy extends the Type interface to include a CopyValue method,
+// used by Copy to create a deep copy of a known value of the type without
+// converting it to its Terraform representation. This preserves value details
+// which are not part of the Terraform representation.
+type TypeWithValueCopy interface {
+	Type
+
+	// CopyValue returns a deep copy of the given known value of the type,
+	// which does not share nested element or attribute storage with it.
+	CopyValue(context.Context, Value) (Value, error)
+}
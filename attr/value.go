@@ -5,6 +5,7 @@ package attr
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -63,3 +64,79 @@ type Value interface {
 	// compatibility guarantees within the framework.
 	String() string
 }
+
+// ValueIsNull returns true if the given Value is nil or its IsNull method
+// returns true. This is a convenience for code which handles arbitrary
+// Value, such as walking schema data, where the Value may be unset.
+func ValueIsNull(v Value) bool {
+	if v == nil {
+		return true
+	}
+
+	return v.IsNull()
+}
+
+// ValueIsUnknown returns true if the given Value is not nil and its IsUnknown
+// method returns true. This is a convenience for code which handles
+// arbitrary Value, such as walking schema data, where the Value may be unset.
+func ValueIsUnknown(v Value) bool {
+	if v == nil {
+		return false
+	}
+
+	return v.IsUnknown()
+}
+
+// Copy returns a deep copy of the given Value, such as for tooling which
+// modifies values, so the copy does not share the nested element or
+// attribute storage of collection and object values. If the Type of the Value
+// implements TypeWithValueCopy, as the collection and object types of the
+// basetypes package do, the copy is rebuilt structurally with its CopyValue
+// method. Otherwise, the copy is created by converting the Value to its
+// Terraform representation and back with the Type of the Value. Custom types
+// are preserved either way. Null, unknown, and primitive values are
+// immutable, so they are returned as-is.
+//
+// Copy returns an error, rather than diagnostics, because this package cannot
+// depend on the diag package.
+func Copy(ctx context.Context, v Value) (Value, error) {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return v, nil
+	}
+
+	typ := v.Type(ctx)
+
+	if typ == nil {
+		return nil, fmt.Errorf("unable to copy value %s: value has no type", v)
+	}
+
+	terraformType := typ.TerraformType(ctx)
+
+	if terraformType.Is(tftypes.Bool) || terraformType.Is(tftypes.Number) || terraformType.Is(tftypes.String) {
+		return v, nil
+	}
+
+	if typWithValueCopy, ok := typ.(TypeWithValueCopy); ok {
+		result, err := typWithValueCopy.CopyValue(ctx, v)
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to copy value %s: %w", v, err)
+		}
+
+		return result, nil
+	}
+
+	terraformValue, err := v.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy value %s: %w", v, err)
+	}
+
+	result, err := typ.ValueFromTerraform(ctx, terraformValue)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy value %s: %w", v, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package attr_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestValueIsNullIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	objectAttributeTypes := map[string]attr.Type{
+		"test": types.StringType,
+	}
+
+	testCases := map[string]struct {
+		value           attr.Value
+		expectedNull    bool
+		expectedUnknown bool
+	}{
+		"nil": {
+			value:        nil,
+			expectedNull: true,
+		},
+		"bool-known": {
+			value: types.BoolValue(true),
+		},
+		"bool-null": {
+			value:        types.BoolNull(),
+			expectedNull: true,
+		},
+		"bool-unknown": {
+			value:           types.BoolUnknown(),
+			expectedUnknown: true,
+		},
+		"float64-known": {
+			value: types.Float64Value(1.2),
+		},
+		"float64-null": {
+			value:        types.Float64Null(),
+			expectedNull: true,
+		},
+		"float64-unknown": {
+			value:           types.Float64Unknown(),
+			expectedUnknown: true,
+		},
+		"int64-known": {
+			value: types.Int64Value(1),
+		},
+		"int64-null": {
+			value:        types.Int64Null(),
+			expectedNull: true,
+		},
+		"int64-unknown": {
+			value:           types.Int64Unknown(),
+			expectedUnknown: true,
+		},
+		"number-known": {
+			value: types.NumberValue(big.NewFloat(1.2)),
+		},
+		"number-null": {
+			value:        types.NumberNull(),
+			expectedNull: true,
+		},
+		"number-unknown": {
+			value:           types.NumberUnknown(),
+			expectedUnknown: true,
+		},
+		"string-known": {
+			value: types.StringValue("test"),
+		},
+		"string-null": {
+			value:        types.StringNull(),
+			expectedNull: true,
+		},
+		"string-unknown": {
+			value:           types.StringUnknown(),
+			expectedUnknown: true,
+		},
+		"list-known": {
+			value: types.ListValueMust(types.StringType, []attr.Value{types.StringNull()}),
+		},
+		"list-null": {
+			value:        types.ListNull(types.StringType),
+			expectedNull: true,
+		},
+		"list-unknown": {
+			value:           types.ListUnknown(types.StringType),
+			expectedUnknown: true,
+		},
+		"map-known": {
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{"test": types.StringUnknown()}),
+		},
+		"map-null": {
+			value:        types.MapNull(types.StringType),
+			expectedNull: true,
+		},
+		"map-unknown": {
+			value:           types.MapUnknown(types.StringType),
+			expectedUnknown: true,
+		},
+		"set-known": {
+			value: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("test")}),
+		},
+		"set-null": {
+			value:        types.SetNull(types.StringType),
+			expectedNull: true,
+		},
+		"set-unknown": {
+			value:           types.SetUnknown(types.StringType),
+			expectedUnknown: true,
+		},
+		"object-known": {
+			value: types.ObjectValueMust(objectAttributeTypes, map[string]attr.Value{"test": types.StringNull()}),
+		},
+		"object-null": {
+			value:        types.ObjectNull(objectAttributeTypes),
+			expectedNull: true,
+		},
+		"object-unknown": {
+			value:           types.ObjectUnknown(objectAttributeTypes),
+			expectedUnknown: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := attr.ValueIsNull(testCase.value); got != testCase.expectedNull {
+				t.Errorf("expected ValueIsNull %t, got %t", testCase.expectedNull, got)
+			}
+
+			if got := attr.ValueIsUnknown(testCase.value); got != testCase.expectedUnknown {
+				t.Errorf("expected ValueIsUnknown %t, got %t", testCase.expectedUnknown, got)
+			}
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	t.Parallel()
+
+	objectAttributeTypes := map[string]attr.Type{
+		"name": types.StringType,
+		"tags": types.SetType{ElemType: types.StringType},
+	}
+	objectType := types.ObjectType{AttrTypes: objectAttributeTypes}
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected attr.Value
+	}{
+		"nil": {
+			value:    nil,
+			expected: nil,
+		},
+		"primitive": {
+			value:    types.StringValue("test"),
+			expected: types.StringValue("test"),
+		},
+		"list-null": {
+			value:    types.ListNull(objectType),
+			expected: types.ListNull(objectType),
+		},
+		"list-unknown": {
+			value:    types.ListUnknown(objectType),
+			expected: types.ListUnknown(objectType),
+		},
+		"list-of-objects": {
+			value: types.ListValueMust(
+				objectType,
+				[]attr.Value{
+					types.ObjectValueMust(
+						objectAttributeTypes,
+						map[string]attr.Value{
+							"name": types.StringValue("first"),
+							"tags": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+					types.ObjectValueMust(
+						objectAttributeTypes,
+						map[string]attr.Value{
+							"name": types.StringUnknown(),
+							"tags": types.SetNull(types.StringType),
+						},
+					),
+				},
+			),
+			expected: types.ListValueMust(
+				objectType,
+				[]attr.Value{
+					types.ObjectValueMust(
+						objectAttributeTypes,
+						map[string]attr.Value{
+							"name": types.StringValue("first"),
+							"tags": types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}),
+						},
+					),
+					types.ObjectValueMust(
+						objectAttributeTypes,
+						map[string]attr.Value{
+							"name": types.StringUnknown(),
+							"tags": types.SetNull(types.StringType),
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := attr.Copy(context.Background(), testCase.value)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.expected == nil {
+				if got != nil {
+					t.Errorf("expected nil, got %s", got)
+				}
+
+				return
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestCopy_nestedListOfObjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	objectAttributeTypes := map[string]attr.Type{
+		"name": types.StringType,
+	}
+	newOriginal := func() types.List {
+		return types.ListValueMust(
+			types.ObjectType{AttrTypes: objectAttributeTypes},
+			[]attr.Value{
+				types.ObjectValueMust(
+					objectAttributeTypes,
+					map[string]attr.Value{
+						"name": types.StringValue("first"),
+					},
+				),
+			},
+		)
+	}
+	original := newOriginal()
+
+	copied, err := attr.Copy(ctx, original)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	copiedList, ok := copied.(types.List)
+
+	if !ok {
+		t.Fatalf("expected types.List, got %T", copied)
+	}
+
+	elements := copiedList.Elements()
+
+	element, ok := elements[0].(types.Object)
+
+	if !ok {
+		t.Fatalf("expected types.Object, got %T", elements[0])
+	}
+
+	changedElement, diags := element.WithAttributeValue("name", types.StringValue("changed"))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	elements[0] = changedElement
+
+	changed, diags := types.ListValue(copiedList.ElementType(ctx), elements)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if changed.Equal(original) {
+		t.Errorf("expected changed copy to differ from original, got %s", changed)
+	}
+
+	if !original.Equal(newOriginal()) {
+		t.Errorf("expected original to be unchanged, got %s", original)
+	}
+}
+
+func TestCopy_stateRedactedList(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"password": types.StringType,
+		"username": types.StringType,
+	}
+	objectTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"username": tftypes.String,
+		},
+	}
+	original := types.ListValueMust(
+		types.ObjectType{AttrTypes: attributeTypes},
+		[]attr.Value{
+			types.ObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"password": types.StringValue("secret"),
+					"username": types.StringValue("admin"),
+				},
+			),
+		},
+	).WithStateRedactedPaths(path.Root("password"))
+
+	// The copy is created in a state serialization context, which must not
+	// redact the copied elements.
+	ctx := fwcontext.WithStateSerialization(context.Background())
+
+	copied, err := attr.Copy(ctx, original)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !copied.Equal(original) {
+		t.Errorf("expected %s, got %s", original, copied)
+	}
+
+	got, err := copied.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+		tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+			"password": tftypes.NewValue(tftypes.String, nil),
+			"username": tftypes.NewValue(tftypes.String, "admin"),
+		}),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestCopy_orderedMap(t *testing.T) {
+	t.Parallel()
+
+	original := basetypes.NewOrderedMapValueMust(
+		types.StringType,
+		[]string{"zulu", "alpha", "mike"},
+		map[string]attr.Value{
+			"alpha": types.StringValue("a"),
+			"mike":  types.StringValue("m"),
+			"zulu":  types.StringValue("z"),
+		},
+	)
+
+	copied, err := attr.Copy(context.Background(), original)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	copiedOrderedMap, ok := copied.(basetypes.OrderedMapValue)
+
+	if !ok {
+		t.Fatalf("expected basetypes.OrderedMapValue, got %T", copied)
+	}
+
+	if !copiedOrderedMap.Equal(original) {
+		t.Errorf("expected %s, got %s", original, copiedOrderedMap)
+	}
+
+	if diff := cmp.Diff(copiedOrderedMap.OrderedKeys(), []string{"zulu", "alpha", "mike"}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -23,3 +23,40 @@ type TypeWithValidate interface {
 	// Type.
 	Validate(context.Context, tftypes.Value, path.Path) diag.Diagnostics
 }
+
+// TypeWithValidateKey extends the attr.Type interface to include a
+// ValidateKey method, used to bundle consistent map key validation logic with
+// the element Type of a map, or with a map Type itself, such as a custom type
+// embedding basetypes.MapType. The framework calls ValidateKey for each key of
+// a known map value. A map Type which implements ValidateKey only validates
+// its own keys, including when it is the element Type of another map.
+type TypeWithValidateKey interface {
+	attr.Type
+
+	// ValidateKey returns any warnings or errors about a map key whose
+	// element is being used to populate the Type. It is generally used to
+	// check the key format and ensure that it complies with the
+	// requirements of the map.
+	ValidateKey(ctx context.Context, key string, path path.Path) diag.Diagnostics
+}
+
+// TypeWithValidateCollection extends the attr.Type interface to include a
+// ValidateCollection method, used to bundle consistent whole-value validation
+// logic with a collection Type, such as checking that an attribute of each
+// element is unique across all elements of a list.
+//
+// The framework calls ValidateCollection wherever it calls Validate, such as
+// for schema data, values converted from Go types, and the elements of
+// collection types, after Validate does not return an error diagnostic.
+// Per-element validation, such as the element type Validate calls of the
+// framework collection types, is performed by Validate, so ValidateCollection
+// only needs to consider the value as a whole.
+type TypeWithValidateCollection interface {
+	attr.Type
+
+	// ValidateCollection returns any warnings or errors about the value
+	// that is being used to populate the Type, considering all of its
+	// elements together. Diagnostics about a specific element should use
+	// the path of that element.
+	ValidateCollection(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics
+}
@@ -4,6 +4,8 @@
 package diag
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
@@ -125,3 +127,112 @@ func (diags Diagnostics) Warnings() Diagnostics {
 
 	return dd
 }
+
+// Filter returns a new collection of the Diagnostic in Diagnostics for which
+// the predicate returns true. The predicate receives the full Diagnostic, so
+// the path of attribute diagnostics is available via the DiagnosticWithPath
+// interface. The original collection is not modified.
+func (diags Diagnostics) Filter(predicate func(Diagnostic) bool) Diagnostics {
+	dd := Diagnostics{}
+
+	for _, d := range diags {
+		if predicate(d) {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// ErrorsOnly returns a new collection of the Diagnostic in Diagnostics that
+// are SeverityError. It is equivalent to the Errors method.
+func (diags Diagnostics) ErrorsOnly() Diagnostics {
+	return diags.Filter(func(d Diagnostic) bool {
+		return d.Severity() == SeverityError
+	})
+}
+
+// WarningsOnly returns a new collection of the Diagnostic in Diagnostics that
+// are SeverityWarning. It is equivalent to the Warnings method.
+func (diags Diagnostics) WarningsOnly() Diagnostics {
+	return diags.Filter(func(d Diagnostic) bool {
+		return d.Severity() == SeverityWarning
+	})
+}
+
+// Dedupe returns a new collection of the Diagnostic in Diagnostics with
+// equal diagnostics removed, preserving the order in which each was first
+// seen. Diagnostics are compared with their Equal method, so attribute
+// diagnostics with the same severity, summary, and detail, but different
+// paths, are not duplicates. Append already skips duplicates, so this is
+// useful for collections built without Append.
+func (diags Diagnostics) Dedupe() Diagnostics {
+	dd := Diagnostics{}
+
+	for _, d := range diags {
+		if d == nil || dd.Contains(d) {
+			continue
+		}
+
+		dd = append(dd, d)
+	}
+
+	return dd
+}
+
+// ToError returns an error aggregating the SeverityError diagnostics in
+// Diagnostics, or nil if there are none. Warning diagnostics are excluded.
+// The error message contains each error diagnostic summary and detail on
+// separate lines. The returned error implements the Unwrap() []error method,
+// compatible with errors.Join, so errors.Is and errors.As in Go 1.20 and
+// later can inspect the individual diagnostic errors.
+func (diags Diagnostics) ToError() error {
+	errs := make([]error, 0, diags.ErrorsCount())
+
+	for _, d := range diags.Errors() {
+		errs = append(errs, diagnosticError{diagnostic: d})
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return diagnosticsError{errs: errs}
+}
+
+// diagnosticError is the error for a single diagnostic returned by the
+// Diagnostics type ToError method.
+type diagnosticError struct {
+	diagnostic Diagnostic
+}
+
+// Error returns the diagnostic summary and detail.
+func (e diagnosticError) Error() string {
+	if e.diagnostic.Detail() == "" {
+		return e.diagnostic.Summary()
+	}
+
+	return e.diagnostic.Summary() + ": " + e.diagnostic.Detail()
+}
+
+// diagnosticsError is the aggregated error returned by the Diagnostics type
+// ToError method.
+type diagnosticsError struct {
+	errs []error
+}
+
+// Error returns the error messages of each diagnostic on separate lines.
+func (e diagnosticsError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns the error of each diagnostic.
+func (e diagnosticsError) Unwrap() []error {
+	return e.errs
+}
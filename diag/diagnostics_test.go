@@ -787,6 +787,80 @@ func TestDiagnosticsErrors(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsToError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags         diag.Diagnostics
+		expected      string
+		expectedCount int
+	}{
+		"nil": {
+			diags: nil,
+		},
+		"empty": {
+			diags: diag.Diagnostics{},
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary 2", ""),
+			},
+			expected:      "Error Summary: Error detail.\nError Summary 2",
+			expectedCount: 2,
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected:      "Error Summary: Error detail.",
+			expectedCount: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.diags.ToError()
+
+			if testCase.expected == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.expected)
+			}
+
+			if err.Error() != testCase.expected {
+				t.Errorf("expected error %q, got %q", testCase.expected, err.Error())
+			}
+
+			unwrapper, ok := err.(interface{ Unwrap() []error })
+
+			if !ok {
+				t.Fatalf("expected error to implement Unwrap() []error, got: %T", err)
+			}
+
+			if got := len(unwrapper.Unwrap()); got != testCase.expectedCount {
+				t.Errorf("expected %d unwrapped errors, got %d", testCase.expectedCount, got)
+			}
+		})
+	}
+}
+
 func TestDiagnosticsWarnings(t *testing.T) {
 	t.Parallel()
 
@@ -833,3 +907,269 @@ func TestDiagnosticsWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestDiagnosticsFilter(t *testing.T) {
+	t.Parallel()
+
+	mixed := diag.Diagnostics{
+		diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+		diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+		diag.NewAttributeErrorDiagnostic(path.Root("deprecated"), "Error Summary", "Error detail."),
+		diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+	}
+
+	type testCase struct {
+		diags     diag.Diagnostics
+		predicate func(diag.Diagnostic) bool
+		expected  diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags: nil,
+			predicate: func(diag.Diagnostic) bool {
+				return true
+			},
+			expected: diag.Diagnostics{},
+		},
+		"all": {
+			diags: mixed,
+			predicate: func(diag.Diagnostic) bool {
+				return true
+			},
+			expected: mixed,
+		},
+		"none": {
+			diags: mixed,
+			predicate: func(diag.Diagnostic) bool {
+				return false
+			},
+			expected: diag.Diagnostics{},
+		},
+		"summary": {
+			diags: mixed,
+			predicate: func(d diag.Diagnostic) bool {
+				return d.Summary() == "Warning Summary"
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+			},
+		},
+		"path": {
+			diags: mixed,
+			predicate: func(d diag.Diagnostic) bool {
+				withPath, ok := d.(diag.DiagnosticWithPath)
+
+				return !ok || !withPath.Path().Equal(path.Root("deprecated"))
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("other"), "Warning Summary", "Warning detail."),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := make(diag.Diagnostics, len(test.diags))
+			copy(original, test.diags)
+
+			got := test.diags.Filter(test.predicate)
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+
+			if diff := cmp.Diff(original, test.diags); diff != "" {
+				t.Fatalf("unexpected modification of original diagnostics: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsErrorsOnly(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: diag.Diagnostics{},
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+		},
+		"warnings": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: diag.Diagnostics{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.ErrorsOnly()
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsWarningsOnly(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: diag.Diagnostics{},
+		},
+		"mixed": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "Warning detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "Warning Summary", "Warning detail."),
+			},
+		},
+		"errors": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+			},
+			expected: diag.Diagnostics{},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.WarningsOnly()
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiagnosticsDedupe(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		diags    diag.Diagnostics
+		expected diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"nil": {
+			diags:    nil,
+			expected: diag.Diagnostics{},
+		},
+		"no-duplicates": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"duplicates": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Error Summary", "Error detail."),
+				diag.NewWarningDiagnostic("Warning Summary", "Warning detail."),
+			},
+		},
+		"different-paths": {
+			diags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(1), "Error Summary", "Error detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(0), "Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test").AtListIndex(1), "Error Summary", "Error detail."),
+			},
+		},
+		"different-severity": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Summary", "Detail."),
+				diag.NewWarningDiagnostic("Summary", "Detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Summary", "Detail."),
+				diag.NewWarningDiagnostic("Summary", "Detail."),
+			},
+		},
+		"different-detail": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail one."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail two."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail one."),
+				diag.NewErrorDiagnostic("Error Summary", "Error detail two."),
+			},
+		},
+		"attribute-and-non-attribute": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "Error Summary", "Error detail."),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.diags.Dedupe()
+
+			if diff := cmp.Diff(test.expected, got); diff != "" {
+				t.Fatalf("expected: %q, got: %q", test.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+)
+
+// DefaultMaxValueLength is the default maximum length, in characters, of a
+// value interpolated into a diagnostic detail by TruncateValue. Provider
+// servers can override it via the providerserver.ServeOpts type
+// MaxDiagnosticValueLength field.
+const DefaultMaxValueLength = 1024
+
+// truncatedValueEllipsis is appended to values truncated by TruncateValue.
+const truncatedValueEllipsis = "..."
+
+// TruncateValue returns the given value representation, such as the String
+// method result of an attr.Value, shortened for interpolation into a
+// diagnostic detail. Values longer than the maximum length are truncated to
+// that length followed by an ellipsis, while shorter values are returned
+// unmodified. The maximum length is DefaultMaxValueLength unless overridden
+// by the provider server.
+func TruncateValue(ctx context.Context, value string) string {
+	maxLength := fwcontext.MaxDiagnosticValueLength(ctx)
+
+	if maxLength <= 0 {
+		maxLength = DefaultMaxValueLength
+	}
+
+	if len(value) <= maxLength {
+		return value
+	}
+
+	// Count characters rather than bytes, so multi-byte characters are never
+	// split.
+	runes := []rune(value)
+
+	if len(runes) <= maxLength {
+		return value
+	}
+
+	return string(runes[:maxLength]) + truncatedValueEllipsis
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diag_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+)
+
+func TestTruncateValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		value    string
+		expected string
+	}{
+		"empty": {
+			ctx:      context.Background(),
+			value:    "",
+			expected: "",
+		},
+		"default-short": {
+			ctx:      context.Background(),
+			value:    "test-value",
+			expected: "test-value",
+		},
+		"default-boundary": {
+			ctx:      context.Background(),
+			value:    strings.Repeat("a", diag.DefaultMaxValueLength),
+			expected: strings.Repeat("a", diag.DefaultMaxValueLength),
+		},
+		"default-truncated": {
+			ctx:      context.Background(),
+			value:    strings.Repeat("a", diag.DefaultMaxValueLength+1),
+			expected: strings.Repeat("a", diag.DefaultMaxValueLength) + "...",
+		},
+		"max-length-boundary": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 5),
+			value:    "abcde",
+			expected: "abcde",
+		},
+		"max-length-truncated": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 5),
+			value:    "abcdef",
+			expected: "abcde...",
+		},
+		"max-length-multibyte-boundary": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 3),
+			value:    "äöü",
+			expected: "äöü",
+		},
+		"max-length-multibyte-truncated": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 3),
+			value:    "äöüß",
+			expected: "äöü...",
+		},
+		"max-length-zero": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 0),
+			value:    strings.Repeat("a", diag.DefaultMaxValueLength+1),
+			expected: strings.Repeat("a", diag.DefaultMaxValueLength) + "...",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := diag.TruncateValue(testCase.ctx, testCase.value)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
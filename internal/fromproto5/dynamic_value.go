@@ -5,11 +5,15 @@ package fromproto5
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // DynamicValue returns the fwschemadata.Data for a given
@@ -32,16 +36,26 @@ func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fw
 		return *data, diags
 	}
 
+	diags.Append(dynamicValueSizeDiagnostics(ctx, proto5, "Unable to Convert "+description.Title(), description.String())...)
+
+	if diags.HasError() {
+		return *data, diags
+	}
+
 	proto5Value, err := proto5.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
-		diags.AddError(
-			"Unable to Convert "+description.Title(),
-			"An unexpected error was encountered when converting the "+description.String()+" from the protocol type. "+
-				"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
-				"Please report this to the provider developer:\n\n"+
-				"Unable to unmarshal DynamicValue: "+err.Error(),
-		)
+		summary := "Unable to Convert " + description.Title()
+		detail := "An unexpected error was encountered when converting the " + description.String() + " from the protocol type. " +
+			"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n" +
+			"Please report this to the provider developer:\n\n" +
+			"Unable to unmarshal DynamicValue: " + err.Error()
+
+		if attributePath, ok := dynamicValueErrorPath(ctx, err, schema); ok {
+			diags.AddAttributeError(attributePath, summary, detail)
+		} else {
+			diags.AddError(summary, detail)
+		}
 
 		return *data, diags
 	}
@@ -52,3 +66,24 @@ func DynamicValue(ctx context.Context, proto5 *tfprotov5.DynamicValue, schema fw
 
 	return *data, diags
 }
+
+// dynamicValueErrorPath returns the path.Path of the attribute which failed
+// decoding, if the error is associated with a path within the schema, so the
+// diagnostic can pinpoint the attribute instead of the whole value.
+func dynamicValueErrorPath(ctx context.Context, err error, schema fwschema.Schema) (path.Path, bool) {
+	var attributePathErr tftypes.AttributePathError
+
+	if !errors.As(err, &attributePathErr) || len(attributePathErr.Path.Steps()) == 0 {
+		return path.Empty(), false
+	}
+
+	attributePath, diags := fromtftypes.AttributePath(ctx, attributePathErr.Path, schema)
+
+	// The error is still returned, just without the attribute path, if the
+	// path cannot be converted.
+	if diags.HasError() {
+		return path.Empty(), false
+	}
+
+	return attributePath, true
+}
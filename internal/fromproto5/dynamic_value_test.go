@@ -8,11 +8,14 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto5"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -100,7 +103,8 @@ func TestDynamicValue(t *testing.T) {
 				TerraformValue: tftypes.Value{},
 			},
 			expectedDiags: diag.Diagnostics{
-				diag.NewErrorDiagnostic(
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
 					"Unable to Convert Configuration",
 					"An unexpected error was encountered when converting the configuration from the protocol type. "+
 						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
@@ -109,6 +113,87 @@ func TestDynamicValue(t *testing.T) {
 				),
 			},
 		},
+		"unmarshal-error-nested": {
+			proto5: DynamicValueMust(tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"inner": tftypes.String,
+								},
+							},
+						},
+					},
+				},
+				map[string]tftypes.Value{
+					"test": tftypes.NewValue(
+						tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"inner": tftypes.String,
+								},
+							},
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"inner": tftypes.String,
+									},
+								},
+								map[string]tftypes.Value{
+									"inner": tftypes.NewValue(tftypes.String, "test-value"),
+								},
+							),
+						},
+					),
+				},
+			)),
+			schema: testschema.Schema{
+				Attributes: map[string]fwschema.Attribute{
+					"test": testschema.Attribute{
+						Optional: true,
+						Type: types.ListType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"inner": types.BoolType, // intentional for testing error
+								},
+							},
+						},
+					},
+				},
+			},
+			description: fwschemadata.DataDescriptionConfiguration,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Optional: true,
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"inner": types.BoolType, // intentional for testing error
+									},
+								},
+							},
+						},
+					},
+				},
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtName("inner"),
+					"Unable to Convert Configuration",
+					"An unexpected error was encountered when converting the configuration from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test\").ElementKeyInt(0).AttributeName(\"inner\"): couldn't decode bool: msgpack: invalid code=aa decoding bool",
+				),
+			},
+		},
 		"attribute-value": {
 			proto5: DynamicValueMust(tftypes.NewValue(
 				tftypes.Object{
@@ -1515,3 +1600,106 @@ func TestDynamicValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicValueMaxDynamicValueSize(t *testing.T) {
+	t.Parallel()
+
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+	proto5 := DynamicValueMust(tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"test": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		},
+	))
+
+	testCases := map[string]struct {
+		maxSize       int
+		expected      fwschemadata.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"unset": {
+			maxSize: 0,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		},
+		"within-limit": {
+			maxSize: 17,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		},
+		"exceeds-limit": {
+			maxSize: 16,
+			expected: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionConfiguration,
+				Schema:         schema,
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Configuration",
+					"The configuration received from Terraform is 17 bytes, which exceeds the maximum size of 16 bytes configured for the provider server. "+
+						"Reduce the size of the data or increase the maximum size in the provider server options.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.maxSize > 0 {
+				ctx = fwcontext.WithMaxDynamicValueSize(ctx, testCase.maxSize)
+			}
+
+			got, diags := fromproto5.DynamicValue(ctx, proto5, schema, fwschemadata.DataDescriptionConfiguration)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
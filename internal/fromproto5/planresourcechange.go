@@ -45,19 +45,19 @@ func PlanResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.PlanResour
 
 	config, configDiags := Config(ctx, proto5.Config, resourceSchema)
 
-	diags.Append(configDiags...)
+	diags.Append(planResourceChangeDecodeDiagnostics("Configuration", "configuration", configDiags)...)
 
 	fw.Config = config
 
 	priorState, priorStateDiags := State(ctx, proto5.PriorState, resourceSchema)
 
-	diags.Append(priorStateDiags...)
+	diags.Append(planResourceChangeDecodeDiagnostics("Prior State", "prior state", priorStateDiags)...)
 
 	fw.PriorState = priorState
 
 	proposedNewState, proposedNewStateDiags := Plan(ctx, proto5.ProposedNewState, resourceSchema)
 
-	diags.Append(proposedNewStateDiags...)
+	diags.Append(planResourceChangeDecodeDiagnostics("Proposed New State", "proposed new state", proposedNewStateDiags)...)
 
 	fw.ProposedNewState = proposedNewState
 
@@ -75,3 +75,26 @@ func PlanResourceChangeRequest(ctx context.Context, proto5 *tfprotov5.PlanResour
 
 	return fw, diags
 }
+
+// planResourceChangeDecodeDiagnostics returns the given diagnostics from
+// decoding the configuration, prior state, or proposed new state of a
+// PlanResourceChangeRequest. If decoding failed, the diagnostics are preceded
+// by a contextual diagnostic which identifies the value.
+func planResourceChangeDecodeDiagnostics(title string, description string, decodeDiags diag.Diagnostics) diag.Diagnostics {
+	if !decodeDiags.HasError() {
+		return decodeDiags
+	}
+
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Invalid Resource Change "+title,
+		"An unexpected error was encountered when decoding the "+description+" of the plan request against the resource schema. "+
+			"This is always an issue in terraform-plugin-framework or Terraform and should be reported to the provider developers.\n\n"+
+			"The "+description+" received from Terraform could not be decoded. The following diagnostics contain additional details.",
+	)
+
+	diags.Append(decodeDiags...)
+
+	return diags
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
 	"github.com/hashicorp/terraform-plugin-framework/internal/privatestate"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -40,6 +41,22 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
 	}
 
+	testProto5MismatchedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"test_attribute": tftypes.Bool,
+		},
+	}
+
+	testProto5MismatchedValue := tftypes.NewValue(testProto5MismatchedType, map[string]tftypes.Value{
+		"test_attribute": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	testProto5MismatchedDynamicValue, err := tfprotov5.NewDynamicValue(testProto5MismatchedType, testProto5MismatchedValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error calling tfprotov5.NewDynamicValue(): %s", err)
+	}
+
 	testFwSchema := schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"test_attribute": schema.StringAttribute{
@@ -125,6 +142,22 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 				ResourceSchema: testFwSchema,
 			},
 		},
+		"priorprivate-malformed-json": {
+			input: &tfprotov5.PlanResourceChangeRequest{
+				PriorPrivate: []byte(`{`),
+			},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.PlanResourceChangeRequest{
+				ResourceSchema: testFwSchema,
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Error Decoding Private State",
+					"An error was encountered when decoding private state: unexpected end of JSON input.\n\n"+
+						"This is always a problem with Terraform or terraform-plugin-framework. Please report this to the provider developer.",
+				),
+			},
+		},
 		"priorstate-missing-schema": {
 			input: &tfprotov5.PlanResourceChangeRequest{
 				PriorState: &testProto5DynamicValue,
@@ -181,6 +214,31 @@ func TestPlanResourceChangeRequest(t *testing.T) {
 				ResourceSchema: testFwSchema,
 			},
 		},
+		"proposednewstate-mismatched-schema": {
+			input: &tfprotov5.PlanResourceChangeRequest{
+				ProposedNewState: &testProto5MismatchedDynamicValue,
+			},
+			resourceSchema: testFwSchema,
+			expected: &fwserver.PlanResourceChangeRequest{
+				ResourceSchema: testFwSchema,
+			},
+			expectedDiagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Resource Change Proposed New State",
+					"An unexpected error was encountered when decoding the proposed new state of the plan request against the resource schema. "+
+						"This is always an issue in terraform-plugin-framework or Terraform and should be reported to the provider developers.\n\n"+
+						"The proposed new state received from Terraform could not be decoded. The following diagnostics contain additional details.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test_attribute"),
+					"Unable to Convert Plan",
+					"An unexpected error was encountered when converting the plan from the protocol type. "+
+						"This is always an issue in terraform-plugin-framework used to implement the provider and should be reported to the provider developers.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"Unable to unmarshal DynamicValue: AttributeName(\"test_attribute\"): error decoding string: msgpack: invalid code=c3 decoding string/bytes length",
+				),
+			},
+		},
 		"providermeta-missing-data": {
 			input:              &tfprotov5.PlanResourceChangeRequest{},
 			resourceSchema:     testFwSchema,
@@ -35,6 +35,12 @@ func ProviderMeta(ctx context.Context, proto5DynamicValue *tfprotov5.DynamicValu
 		return fw, nil
 	}
 
+	diags.Append(dynamicValueSizeDiagnostics(ctx, proto5DynamicValue, "Unable to Convert Provider Meta Configuration", "provider meta configuration")...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	proto5Value, err := proto5DynamicValue.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
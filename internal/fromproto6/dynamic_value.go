@@ -32,6 +32,12 @@ func DynamicValue(ctx context.Context, proto6 *tfprotov6.DynamicValue, schema fw
 		return *data, diags
 	}
 
+	diags.Append(dynamicValueSizeDiagnostics(ctx, proto6, "Unable to Convert "+description.Title(), description.String())...)
+
+	if diags.HasError() {
+		return *data, diags
+	}
+
 	proto6Value, err := proto6.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fromproto6
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// dynamicValueSizeDiagnostics returns an error diagnostic with the given
+// summary if the encoded size of the DynamicValue exceeds the maximum size
+// set via fwcontext.WithMaxDynamicValueSize, before the value is decoded.
+// The description is the kind of data used in the diagnostic detail, such as
+// "configuration".
+func dynamicValueSizeDiagnostics(ctx context.Context, proto6 *tfprotov6.DynamicValue, summary string, description string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	maxSize := fwcontext.MaxDynamicValueSize(ctx)
+
+	if maxSize <= 0 || proto6 == nil {
+		return diags
+	}
+
+	size := len(proto6.MsgPack) + len(proto6.JSON)
+
+	if size <= maxSize {
+		return diags
+	}
+
+	diags.AddError(
+		summary,
+		fmt.Sprintf("The %s received from Terraform is %d bytes, which exceeds the maximum size of %d bytes configured for the provider server. ", description, size, maxSize)+
+			"Reduce the size of the data or increase the maximum size in the provider server options.",
+	)
+
+	return diags
+}
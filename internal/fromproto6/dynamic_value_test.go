@@ -10,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fromproto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
 	"github.com/hashicorp/terraform-plugin-framework/internal/testing/testschema"
@@ -1515,3 +1516,106 @@ func TestDynamicValue(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicValueMaxDynamicValueSize(t *testing.T) {
+	t.Parallel()
+
+	schema := testschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			"test": testschema.Attribute{
+				Optional: true,
+				Type:     types.StringType,
+			},
+		},
+	}
+	proto6 := DynamicValueMust(tftypes.NewValue(
+		tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"test": tftypes.String,
+			},
+		},
+		map[string]tftypes.Value{
+			"test": tftypes.NewValue(tftypes.String, "test-value"),
+		},
+	))
+
+	testCases := map[string]struct {
+		maxSize       int
+		expected      fwschemadata.Data
+		expectedDiags diag.Diagnostics
+	}{
+		"unset": {
+			maxSize: 0,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		},
+		"within-limit": {
+			maxSize: 17,
+			expected: fwschemadata.Data{
+				Description: fwschemadata.DataDescriptionConfiguration,
+				Schema:      schema,
+				TerraformValue: tftypes.NewValue(
+					tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.String,
+						},
+					},
+					map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.String, "test-value"),
+					},
+				),
+			},
+		},
+		"exceeds-limit": {
+			maxSize: 16,
+			expected: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionConfiguration,
+				Schema:         schema,
+				TerraformValue: tftypes.Value{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Convert Configuration",
+					"The configuration received from Terraform is 17 bytes, which exceeds the maximum size of 16 bytes configured for the provider server. "+
+						"Reduce the size of the data or increase the maximum size in the provider server options.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.maxSize > 0 {
+				ctx = fwcontext.WithMaxDynamicValueSize(ctx, testCase.maxSize)
+			}
+
+			got, diags := fromproto6.DynamicValue(ctx, proto6, schema, fwschemadata.DataDescriptionConfiguration)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
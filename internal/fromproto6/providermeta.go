@@ -35,6 +35,12 @@ func ProviderMeta(ctx context.Context, proto6DynamicValue *tfprotov6.DynamicValu
 		return fw, nil
 	}
 
+	diags.Append(dynamicValueSizeDiagnostics(ctx, proto6DynamicValue, "Unable to Convert Provider Meta Configuration", "provider meta configuration")...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	proto6Value, err := proto6DynamicValue.Unmarshal(schema.Type().TerraformType(ctx))
 
 	if err != nil {
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwcontext contains framework-specific context values which are
+// shared between the framework server and value types.
+package fwcontext
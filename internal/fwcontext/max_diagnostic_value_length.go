@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import "context"
+
+// maxDiagnosticValueLengthKey is the context key for
+// WithMaxDiagnosticValueLength.
+type maxDiagnosticValueLengthKey struct{}
+
+// WithMaxDiagnosticValueLength returns a context which signals to
+// diagnostic creation the maximum length, in characters, of a value
+// interpolated into a diagnostic detail. A length of zero or less uses the
+// default length.
+func WithMaxDiagnosticValueLength(ctx context.Context, length int) context.Context {
+	return context.WithValue(ctx, maxDiagnosticValueLengthKey{}, length)
+}
+
+// MaxDiagnosticValueLength returns the maximum length, in characters, of a
+// value interpolated into a diagnostic detail set by
+// WithMaxDiagnosticValueLength. Returns zero, which uses the default length,
+// if no length was set.
+func MaxDiagnosticValueLength(ctx context.Context) int {
+	length, ok := ctx.Value(maxDiagnosticValueLengthKey{}).(int)
+
+	if !ok {
+		return 0
+	}
+
+	return length
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+)
+
+func TestMaxDiagnosticValueLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected int
+	}{
+		"background": {
+			ctx:      context.Background(),
+			expected: 0,
+		},
+		"max-diagnostic-value-length": {
+			ctx:      fwcontext.WithMaxDiagnosticValueLength(context.Background(), 1024),
+			expected: 1024,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwcontext.MaxDiagnosticValueLength(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %d, got %d", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import "context"
+
+// maxDynamicValueSizeKey is the context key for WithMaxDynamicValueSize.
+type maxDynamicValueSizeKey struct{}
+
+// WithMaxDynamicValueSize returns a context which signals to protocol
+// conversion the maximum size, in bytes, of a protocol DynamicValue to
+// decode. A size of zero or less is unlimited.
+func WithMaxDynamicValueSize(ctx context.Context, size int) context.Context {
+	return context.WithValue(ctx, maxDynamicValueSizeKey{}, size)
+}
+
+// MaxDynamicValueSize returns the maximum size, in bytes, of a protocol
+// DynamicValue to decode set by WithMaxDynamicValueSize. Returns zero, which
+// is unlimited, if no size was set.
+func MaxDynamicValueSize(ctx context.Context) int {
+	size, ok := ctx.Value(maxDynamicValueSizeKey{}).(int)
+
+	if !ok {
+		return 0
+	}
+
+	return size
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import "context"
+
+// providerValueKey is the context key for WithProviderValue.
+type providerValueKey struct{}
+
+// WithProviderValue returns a context which signals to value types that the
+// value was created by the provider, such as with the (tfsdk.State).Set
+// method, rather than received from Terraform.
+func WithProviderValue(ctx context.Context) context.Context {
+	return context.WithValue(ctx, providerValueKey{}, true)
+}
+
+// IsProviderValue returns true if the context was created by
+// WithProviderValue.
+func IsProviderValue(ctx context.Context) bool {
+	isProviderValue, ok := ctx.Value(providerValueKey{}).(bool)
+
+	return ok && isProviderValue
+}
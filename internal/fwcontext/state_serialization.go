@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import "context"
+
+// stateSerializationKey is the context key for WithStateSerialization.
+type stateSerializationKey struct{}
+
+// WithStateSerialization returns a context which signals to value types that
+// the value is being converted for storage in Terraform state.
+func WithStateSerialization(ctx context.Context) context.Context {
+	return context.WithValue(ctx, stateSerializationKey{}, true)
+}
+
+// IsStateSerialization returns true if the context was created by
+// WithStateSerialization.
+func IsStateSerialization(ctx context.Context) bool {
+	isState, ok := ctx.Value(stateSerializationKey{}).(bool)
+
+	return ok && isState
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/stringintern"
+)
+
+// stringInternerKey is the context key for WithStringInterner.
+type stringInternerKey struct{}
+
+// WithStringInterner returns a context which signals to value types that
+// converted string values should be interned with the given cache.
+func WithStringInterner(ctx context.Context, cache *stringintern.Cache) context.Context {
+	return context.WithValue(ctx, stringInternerKey{}, cache)
+}
+
+// StringInterner returns the cache set by WithStringInterner. Returns nil,
+// which does not intern strings, if no cache was set.
+func StringInterner(ctx context.Context) *stringintern.Cache {
+	cache, ok := ctx.Value(stringInternerKey{}).(*stringintern.Cache)
+
+	if !ok {
+		return nil
+	}
+
+	return cache
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwcontext_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/stringintern"
+)
+
+func TestStringInterner(t *testing.T) {
+	t.Parallel()
+
+	cache := stringintern.New(10)
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected *stringintern.Cache
+	}{
+		"background": {
+			ctx:      context.Background(),
+			expected: nil,
+		},
+		"string-interner": {
+			ctx:      fwcontext.WithStringInterner(context.Background(), cache),
+			expected: cache,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwcontext.StringInterner(testCase.ctx)
+
+			if got != testCase.expected {
+				t.Errorf("expected %p, got %p", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package fwschema
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -238,3 +239,77 @@ func SchemaType(s Schema) attr.Type {
 
 	return types.ObjectType{AttrTypes: attrTypes}
 }
+
+// SchemaWalkTypes is a helper function to perform type traversal using the
+// Type method. Each type is passed to `fn` before any of its nested types,
+// descending into object attribute types and collection or tuple element
+// types. Elements are given representative paths: list index 0 for lists,
+// each index for tuples, an empty key for maps, and the element type
+// ValueType value for sets.
+func SchemaWalkTypes(ctx context.Context, s Schema, fn func(path.Path, attr.Type) error) error {
+	schemaType, ok := s.Type().(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		return nil
+	}
+
+	return walkAttributeTypes(ctx, path.Empty(), schemaType.AttributeTypes(), fn)
+}
+
+// walkAttributeTypes calls walkType for each of the given attribute types in
+// name order.
+func walkAttributeTypes(ctx context.Context, p path.Path, attrTypes map[string]attr.Type, fn func(path.Path, attr.Type) error) error {
+	names := make([]string, 0, len(attrTypes))
+
+	for name := range attrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := walkType(ctx, p.AtName(name), attrTypes[name], fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkType calls `fn` with the given path and type, then descends into any
+// nested types.
+func walkType(ctx context.Context, p path.Path, typ attr.Type, fn func(path.Path, attr.Type) error) error {
+	if err := fn(p, typ); err != nil {
+		return err
+	}
+
+	switch typ := typ.(type) {
+	case attr.TypeWithAttributeTypes:
+		return walkAttributeTypes(ctx, p, typ.AttributeTypes(), fn)
+	case attr.TypeWithElementTypes:
+		for index, elementType := range typ.ElementTypes() {
+			if err := walkType(ctx, p.AtListIndex(index), elementType, fn); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case attr.TypeWithElementType:
+		elementType := typ.ElementType()
+
+		if elementType == nil {
+			return nil
+		}
+
+		switch typ.TerraformType(ctx).(type) {
+		case tftypes.Map:
+			return walkType(ctx, p.AtMapKey(""), elementType, fn)
+		case tftypes.Set:
+			return walkType(ctx, p.AtSetValue(elementType.ValueType(ctx)), elementType, fn)
+		default:
+			return walkType(ctx, p.AtListIndex(0), elementType, fn)
+		}
+	default:
+		return nil
+	}
+}
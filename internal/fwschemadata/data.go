@@ -4,6 +4,9 @@
 package fwschemadata
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -25,3 +28,17 @@ type Data struct {
 	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
 	TerraformValue tftypes.Value
 }
+
+// serializationContext returns the context to use when converting values into
+// the TerraformValue. Values are marked as created by the provider, and state
+// data is also marked so value types can omit data which should not be
+// persisted.
+func (d Data) serializationContext(ctx context.Context) context.Context {
+	ctx = fwcontext.WithProviderValue(ctx)
+
+	if d.Description == DataDescriptionState {
+		return fwcontext.WithStateSerialization(ctx)
+	}
+
+	return ctx
+}
@@ -15,6 +15,8 @@ import (
 // Set replaces the entire value. The value should be a struct whose fields
 // have one of the attr.Value types. Each field must have the tfsdk field tag.
 func (d *Data) Set(ctx context.Context, val any) diag.Diagnostics {
+	ctx = d.serializationContext(ctx)
+
 	attrValue, diags := reflect.FromValue(ctx, d.Schema.Type(), val, path.Empty())
 
 	if diags.HasError() {
@@ -8,7 +8,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
@@ -29,6 +28,7 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 	var diags diag.Diagnostics
 
 	ctx = logging.FrameworkWithAttributePath(ctx, path.String())
+	ctx = d.serializationContext(ctx)
 
 	tftypesPath, tftypesPathDiags := totftypes.AttributePath(ctx, path)
 
@@ -69,15 +69,10 @@ func (d *Data) SetAtPath(ctx context.Context, path path.Path, val interface{}) d
 		return diags
 	}
 
-	if attrTypeWithValidate, ok := attrType.(xattr.TypeWithValidate); ok {
-		logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
-		logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
-		diags.Append(attrTypeWithValidate.Validate(ctx, tfVal, path)...)
-		logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
+	diags.Append(validateAttrType(ctx, attrType, tfVal, path)...)
 
-		if diags.HasError() {
-			return diags
-		}
+	if diags.HasError() {
+		return diags
 	}
 
 	transformFunc, transformFuncDiags := d.SetAtPathTransformFunc(ctx, path, tfVal, nil)
@@ -187,15 +182,10 @@ func (d Data) SetAtPathTransformFunc(ctx context.Context, path path.Path, tfVal
 		return nil, diags
 	}
 
-	if attrTypeWithValidate, ok := parentAttrType.(xattr.TypeWithValidate); ok {
-		logging.FrameworkTrace(ctx, "Type implements TypeWithValidate")
-		logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
-		diags.Append(attrTypeWithValidate.Validate(ctx, parentValue, parentPath)...)
-		logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
+	diags.Append(validateAttrType(ctx, parentAttrType, parentValue, parentPath)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	return d.SetAtPathTransformFunc(ctx, parentPath, parentValue, diags)
@@ -2542,6 +2542,59 @@ func TestDataSetAtPath(t *testing.T) {
 				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
+		"AttrTypeWithValidateCollection-duplicate": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+								},
+							},
+						},
+					},
+				}, nil),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: testtypes.ListTypeWithUniqueNames{
+								ListType: types.ListType{
+									ElemType: types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"name": types.StringType,
+										},
+									},
+								},
+							},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			val: []struct {
+				Name string `tfsdk:"name"`
+			}{
+				{Name: "one"},
+				{Name: "two"},
+				{Name: "one"},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"test": tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+					},
+				},
+			}, nil),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestDuplicateNameDiagnostic(path.Root("test").AtListIndex(2), "one", 0),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
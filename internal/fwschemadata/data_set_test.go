@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschema"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwschemadata"
@@ -85,6 +86,33 @@ func TestDataSet(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
 		},
+		"set-null-element": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.Value{},
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"tags": testschema.Attribute{
+							Type:     types.SetType{ElemType: types.StringType},
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Tags []*string `tfsdk:"tags"`
+			}{
+				Tags: []*string{nil},
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"tags": tftypes.Set{ElementType: tftypes.String},
+				},
+			}, map[string]tftypes.Value{
+				"tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		},
 		"multiple-attributes": {
 			data: fwschemadata.Data{
 				TerraformValue: tftypes.Value{},
@@ -172,6 +200,82 @@ func TestDataSet(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
+		"state-redacted-paths-configuration": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionConfiguration,
+				TerraformValue: tftypes.Value{},
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"credentials": testschema.Attribute{
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"password": types.StringType,
+										"username": types.StringType,
+									},
+								},
+							},
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Credentials types.List `tfsdk:"credentials"`
+			}{
+				Credentials: redactedCredentialsList(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"credentials": credentialsListTerraformType,
+				},
+			}, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(credentialsListTerraformType, []tftypes.Value{
+					tftypes.NewValue(credentialsListTerraformType.ElementType, map[string]tftypes.Value{
+						"password": tftypes.NewValue(tftypes.String, "secret"),
+						"username": tftypes.NewValue(tftypes.String, "admin"),
+					}),
+				}),
+			}),
+		},
+		"state-redacted-paths-state": {
+			data: fwschemadata.Data{
+				Description:    fwschemadata.DataDescriptionState,
+				TerraformValue: tftypes.Value{},
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"credentials": testschema.Attribute{
+							Type: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"password": types.StringType,
+										"username": types.StringType,
+									},
+								},
+							},
+							Required: true,
+						},
+					},
+				},
+			},
+			val: struct {
+				Credentials types.List `tfsdk:"credentials"`
+			}{
+				Credentials: redactedCredentialsList(),
+			},
+			expected: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"credentials": credentialsListTerraformType,
+				},
+			}, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(credentialsListTerraformType, []tftypes.Value{
+					tftypes.NewValue(credentialsListTerraformType.ElementType, map[string]tftypes.Value{
+						"password": tftypes.NewValue(tftypes.String, nil),
+						"username": tftypes.NewValue(tftypes.String, "admin"),
+					}),
+				}),
+			}),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -191,3 +295,32 @@ func TestDataSet(t *testing.T) {
 		})
 	}
 }
+
+var credentialsListTerraformType = tftypes.List{
+	ElementType: tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"username": tftypes.String,
+		},
+	},
+}
+
+func redactedCredentialsList() types.List {
+	attrTypes := map[string]attr.Type{
+		"password": types.StringType,
+		"username": types.StringType,
+	}
+
+	return types.ListValueMust(
+		types.ObjectType{AttrTypes: attrTypes},
+		[]attr.Value{
+			types.ObjectValueMust(
+				attrTypes,
+				map[string]attr.Value{
+					"password": types.StringValue("secret"),
+					"username": types.StringValue("admin"),
+				},
+			),
+		},
+	).WithStateRedactedPaths(path.Root("password"))
+}
@@ -1774,6 +1774,151 @@ func TestDataValueAtPath(t *testing.T) {
 			expected:      testtypes.String{InternalString: types.StringValue("value"), CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
+		"AttrTypeWithValidateCollection": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+								},
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+					}, []tftypes.Value{
+						tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "one"),
+						}),
+						tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "two"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: testtypes.ListTypeWithUniqueNames{
+								ListType: types.ListType{
+									ElemType: types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"name": types.StringType,
+										},
+									},
+								},
+							},
+							Required: true,
+						},
+					},
+				},
+			},
+			path: path.Root("test"),
+			expected: types.ListValueMust(
+				types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+				[]attr.Value{
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"name": types.StringType,
+						},
+						map[string]attr.Value{
+							"name": types.StringValue("one"),
+						},
+					),
+					types.ObjectValueMust(
+						map[string]attr.Type{
+							"name": types.StringType,
+						},
+						map[string]attr.Value{
+							"name": types.StringValue("two"),
+						},
+					),
+				},
+			),
+			expectedDiags: nil,
+		},
+		"AttrTypeWithValidateCollection-duplicate": {
+			data: fwschemadata.Data{
+				TerraformValue: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"test": tftypes.List{
+							ElementType: tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"name": tftypes.String,
+								},
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"test": tftypes.NewValue(tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						},
+					}, []tftypes.Value{
+						tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "one"),
+						}),
+						tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "two"),
+						}),
+						tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"name": tftypes.String,
+							},
+						}, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "one"),
+						}),
+					}),
+				}),
+				Schema: testschema.Schema{
+					Attributes: map[string]fwschema.Attribute{
+						"test": testschema.Attribute{
+							Type: testtypes.ListTypeWithUniqueNames{
+								ListType: types.ListType{
+									ElemType: types.ObjectType{
+										AttrTypes: map[string]attr.Type{
+											"name": types.StringType,
+										},
+									},
+								},
+							},
+							Required: true,
+						},
+					},
+				},
+			},
+			path:          path.Root("test"),
+			expected:      nil,
+			expectedDiags: diag.Diagnostics{testtypes.TestDuplicateNameDiagnostic(path.Root("test").AtListIndex(2), "one", 0)},
+		},
 	}
 
 	for name, tc := range testCases {
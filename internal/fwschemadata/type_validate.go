@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwschemadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateAttrType calls the provider defined validation of the given type,
+// if any, with fwtype.Validate.
+func validateAttrType(ctx context.Context, attrType attr.Type, tfValue tftypes.Value, attrPath path.Path) diag.Diagnostics {
+	if !fwtype.HasValidation(attrType) {
+		return nil
+	}
+
+	logging.FrameworkDebug(ctx, "Calling provider defined Type Validate")
+	diags := fwtype.Validate(ctx, attrType, tfValue, attrPath)
+	logging.FrameworkDebug(ctx, "Called provider defined Type Validate")
+
+	return diags
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fwtype contains shared logic for calling the provider defined
+// validation of attr.Type implementations.
+package fwtype
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtype
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// HasValidation returns true if the given type implements any provider
+// defined validation which Validate calls.
+func HasValidation(typ attr.Type) bool {
+	if _, ok := typ.(xattr.TypeWithValidate); ok {
+		return true
+	}
+
+	if _, ok := typ.(xattr.TypeWithValidateKey); ok {
+		return true
+	}
+
+	if _, ok := typ.(xattr.TypeWithValidateCollection); ok {
+		return true
+	}
+
+	return false
+}
+
+// Validate calls the provider defined validation of the given type, if any,
+// for the given value. When the xattr.TypeWithValidate validation returns no
+// errors and the value is a known map, the xattr.TypeWithValidateKey
+// validation of the type itself, such as a custom type embedding MapType, is
+// called for each map key in sorted order. The
+// xattr.TypeWithValidateCollection validation is then called when the prior
+// validation returns no errors.
+func Validate(ctx context.Context, typ attr.Type, in tftypes.Value, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+		diags.Append(typeWithValidate.Validate(ctx, in, p)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if typeWithValidateKey, ok := typ.(xattr.TypeWithValidateKey); ok {
+		diags.Append(validateMapKeys(ctx, typeWithValidateKey, in, p)...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if typeWithValidateCollection, ok := typ.(xattr.TypeWithValidateCollection); ok {
+		diags.Append(typeWithValidateCollection.ValidateCollection(ctx, in, p)...)
+	}
+
+	return diags
+}
+
+// validateMapKeys calls ValidateKey for each key of the given value, in
+// sorted order, if the value is a known map. Otherwise, no diagnostics are
+// returned.
+func validateMapKeys(ctx context.Context, typ xattr.TypeWithValidateKey, in tftypes.Value, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.Type().Is(tftypes.Map{}) || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var elems map[string]tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddAttributeError(
+			p,
+			"Map Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	keys := make([]string, 0, len(elems))
+
+	for key := range elems {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diags.Append(typ.ValidateKey(ctx, key, p.AtMapKey(key))...)
+	}
+
+	return diags
+}
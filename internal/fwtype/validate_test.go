@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwtype_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ xattr.TypeWithValidateKey = lowercaseKeyMapType{}
+
+// lowercaseKeyMapType is a custom map type which rejects map keys containing
+// uppercase letters.
+type lowercaseKeyMapType struct {
+	types.MapType
+}
+
+func (t lowercaseKeyMapType) ValidateKey(_ context.Context, key string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if strings.ToLower(key) != key {
+		diags.AddAttributeError(
+			path,
+			"Invalid Map Key",
+			"Map keys must not contain uppercase letters, got: "+key,
+		)
+	}
+
+	return diags
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	nameAttributeTypes := map[string]attr.Type{
+		"name": types.StringType,
+	}
+	nameObjectTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		typ           attr.Type
+		tfValue       tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"no-validation": {
+			typ:     types.StringType,
+			tfValue: tftypes.NewValue(tftypes.String, "TestValue"),
+		},
+		"map-type-key-validation-null": {
+			typ: lowercaseKeyMapType{
+				MapType: types.MapType{ElemType: types.StringType},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		},
+		"map-type-key-validation-unknown": {
+			typ: lowercaseKeyMapType{
+				MapType: types.MapType{ElemType: types.StringType},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+		},
+		"map-type-key-validation-valid": {
+			typ: lowercaseKeyMapType{
+				MapType: types.MapType{ElemType: types.StringType},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"testkey": tftypes.NewValue(tftypes.String, "TestValue"),
+			}),
+		},
+		"map-type-key-validation-invalid": {
+			typ: lowercaseKeyMapType{
+				MapType: types.MapType{ElemType: types.StringType},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+				"TestKey": tftypes.NewValue(tftypes.String, "testvalue"),
+				"TESTKEY": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("TESTKEY"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TESTKEY",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
+		"map-type-key-validation-nested": {
+			typ: types.ListType{
+				ElemType: lowercaseKeyMapType{
+					MapType: types.MapType{ElemType: types.StringType},
+				},
+			},
+			tfValue: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.Map{ElementType: tftypes.String}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"TestKey": tftypes.NewValue(tftypes.String, "testvalue"),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
+		"collection-validation-invalid": {
+			typ: testtypes.ListTypeWithUniqueNames{
+				ListType: types.ListType{
+					ElemType: types.ObjectType{AttrTypes: nameAttributeTypes},
+				},
+			},
+			tfValue: tftypes.NewValue(
+				tftypes.List{ElementType: nameObjectTfType},
+				[]tftypes.Value{
+					tftypes.NewValue(nameObjectTfType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "first"),
+					}),
+					tftypes.NewValue(nameObjectTfType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "first"),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestDuplicateNameDiagnostic(path.Root("test").AtListIndex(1), "first", 0),
+			},
+		},
+		"collection-validation-nested": {
+			typ: types.SetType{
+				ElemType: testtypes.ListTypeWithUniqueNames{
+					ListType: types.ListType{
+						ElemType: types.ObjectType{AttrTypes: nameAttributeTypes},
+					},
+				},
+			},
+			tfValue: tftypes.NewValue(
+				tftypes.Set{ElementType: tftypes.List{ElementType: nameObjectTfType}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.List{ElementType: nameObjectTfType}, []tftypes.Value{
+						tftypes.NewValue(nameObjectTfType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "first"),
+						}),
+						tftypes.NewValue(nameObjectTfType, map[string]tftypes.Value{
+							"name": tftypes.NewValue(tftypes.String, "first"),
+						}),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				testtypes.TestDuplicateNameDiagnostic(
+					path.Root("test").AtSetValue(types.ListValueMust(
+						types.ObjectType{AttrTypes: nameAttributeTypes},
+						[]attr.Value{
+							types.ObjectValueMust(nameAttributeTypes, map[string]attr.Value{
+								"name": types.StringValue("first"),
+							}),
+							types.ObjectValueMust(nameAttributeTypes, map[string]attr.Value{
+								"name": types.StringValue("first"),
+							}),
+						},
+					)).AtListIndex(1),
+					"first",
+					0,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fwtype.Validate(context.Background(), testCase.typ, testCase.tfValue, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/internal/fwserver"
+	"github.com/hashicorp/terraform-plugin-framework/internal/stringintern"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -17,12 +19,47 @@ var _ tfprotov6.ProviderServer = &Server{}
 type Server struct {
 	FrameworkServer fwserver.Server
 
+	// MaxDynamicValueSize, when greater than zero, is the maximum size in
+	// bytes of a request DynamicValue, such as configuration, plan, or state
+	// data, to decode. Larger values return an error diagnostic. By default,
+	// the size is unlimited.
+	MaxDynamicValueSize int
+
+	// MaxDiagnosticValueLength, when greater than zero, is the maximum
+	// length in characters of values interpolated into diagnostic details
+	// via diag.TruncateValue. By default, diag.DefaultMaxValueLength is used.
+	MaxDiagnosticValueLength int
+
+	// StringInternCacheSize, when greater than zero, is the number of
+	// distinct strings kept in a least recently used cache for interning
+	// string values converted from Terraform data, which reduces memory
+	// usage when large collections contain many repeated strings. By
+	// default, strings are not interned.
+	StringInternCacheSize int
+
+	// stringInterner is created on first use from StringInternCacheSize and
+	// shared across requests.
+	stringInterner     *stringintern.Cache
+	stringInternerOnce sync.Once
+
 	contextCancels   []context.CancelFunc
 	contextCancelsMu sync.Mutex
 }
 
 func (s *Server) registerContext(in context.Context) context.Context {
 	ctx, cancel := context.WithCancel(in)
+	if s.MaxDynamicValueSize > 0 {
+		ctx = fwcontext.WithMaxDynamicValueSize(ctx, s.MaxDynamicValueSize)
+	}
+	if s.MaxDiagnosticValueLength > 0 {
+		ctx = fwcontext.WithMaxDiagnosticValueLength(ctx, s.MaxDiagnosticValueLength)
+	}
+	if s.StringInternCacheSize > 0 {
+		s.stringInternerOnce.Do(func() {
+			s.stringInterner = stringintern.New(s.StringInternCacheSize)
+		})
+		ctx = fwcontext.WithStringInterner(ctx, s.stringInterner)
+	}
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
 	s.contextCancels = append(s.contextCancels, cancel)
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -54,6 +55,31 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// canceled, or we have an error reported
 }
 
+func TestServerRegisterContextStringInterner(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+
+	if got := fwcontext.StringInterner(s.registerContext(context.Background())); got != nil {
+		t.Errorf("expected no string interner by default, got %p", got)
+	}
+
+	s = &Server{
+		StringInternCacheSize: 10,
+	}
+
+	first := fwcontext.StringInterner(s.registerContext(context.Background()))
+	second := fwcontext.StringInterner(s.registerContext(context.Background()))
+
+	if first == nil {
+		t.Fatal("expected string interner, got none")
+	}
+
+	if first != second {
+		t.Errorf("expected string interner to be shared across requests")
+	}
+}
+
 func testNewDynamicValue(t *testing.T, schemaType tftypes.Type, schemaValue map[string]tftypes.Value) *tfprotov6.DynamicValue {
 	t.Helper()
 
@@ -9,8 +9,8 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -74,12 +74,10 @@ func FromUnknownable(ctx context.Context, typ attr.Type, val Unknownable, path p
 	if val.GetUnknown(ctx) {
 		tfVal := tftypes.NewValue(typ.TerraformType(ctx), tftypes.UnknownValue)
 
-		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		res, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -95,12 +93,10 @@ func FromUnknownable(ctx context.Context, typ attr.Type, val Unknownable, path p
 
 	tfVal := tftypes.NewValue(typ.TerraformType(ctx), val.GetValue(ctx))
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	res, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -168,12 +164,10 @@ func FromNullable(ctx context.Context, typ attr.Type, val Nullable, path path.Pa
 	if val.GetNull(ctx) {
 		tfVal := tftypes.NewValue(typ.TerraformType(ctx), nil)
 
-		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		res, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -189,12 +183,10 @@ func FromNullable(ctx context.Context, typ attr.Type, val Nullable, path path.Pa
 
 	tfVal := tftypes.NewValue(typ.TerraformType(ctx), val.GetValue(ctx))
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	res, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -260,12 +252,10 @@ func FromValueCreator(ctx context.Context, typ attr.Type, val tftypes.ValueCreat
 	}
 	tfVal := tftypes.NewValue(typ.TerraformType(ctx), raw)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	res, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -283,12 +273,10 @@ func FromValueCreator(ctx context.Context, typ attr.Type, val tftypes.ValueCreat
 func NewAttributeValue(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, val, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, val, path)...)
 
-		if diags.HasError() {
-			return target, diags
-		}
+	if diags.HasError() {
+		return target, diags
 	}
 
 	res, err := typ.ValueFromTerraform(ctx, val)
@@ -337,13 +325,13 @@ func FromAttributeValue(ctx context.Context, typ attr.Type, val attr.Value, path
 		return nil, diags
 	}
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
+	if fwtype.HasValidation(typ) {
 		tfVal, err := val.ToTerraformValue(ctx)
 		if err != nil {
 			return val, append(diags, toTerraformValueErrorDiag(err, path))
 		}
 
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
 		if diags.HasError() {
 			return val, diags
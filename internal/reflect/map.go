@@ -9,8 +9,8 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -103,12 +103,10 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 	if val.IsNil() {
 		tfVal := tftypes.NewValue(tfType, nil)
 
-		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -148,12 +146,10 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 			return nil, append(diags, toTerraformValueErrorDiag(err, path))
 		}
 
-		if typeWithValidate, ok := elemType.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path.AtMapKey(key.String()))...)
+		diags.Append(fwtype.Validate(ctx, elemType, tfVal, path.AtMapKey(key.String()))...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		tfElems[key.String()] = tfVal
@@ -166,12 +162,10 @@ func FromMap(ctx context.Context, typ attr.TypeWithElementType, val reflect.Valu
 
 	tfVal := tftypes.NewValue(tfType, tfElems)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
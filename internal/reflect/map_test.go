@@ -8,7 +8,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -46,3 +50,39 @@ func TestReflectMap_string(t *testing.T) {
 		}
 	}
 }
+
+func TestFromValue_mapOfListTypeWithValidateCollection(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Name string `tfsdk:"name"`
+	}
+
+	typ := types.MapType{
+		ElemType: testtypes.ListTypeWithUniqueNames{
+			ListType: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+			},
+		},
+	}
+	val := map[string][]element{
+		"test": {
+			{Name: "first"},
+			{Name: "first"},
+		},
+	}
+
+	_, diags := refl.FromValue(context.Background(), typ, val, path.Empty())
+
+	expectedDiags := diag.Diagnostics{
+		testtypes.TestDuplicateNameDiagnostic(path.Empty().AtMapKey("test").AtListIndex(1), "first", 0),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -12,8 +12,8 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -249,12 +249,10 @@ func FromInt(ctx context.Context, typ attr.Type, val int64, path path.Path) (att
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfNum, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfNum, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
@@ -276,12 +274,10 @@ func FromUint(ctx context.Context, typ attr.Type, val uint64, path path.Path) (a
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfNum, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfNum, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
@@ -303,12 +299,10 @@ func FromFloat(ctx context.Context, typ attr.Type, val float64, path path.Path)
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfNum, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfNum, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
@@ -330,12 +324,10 @@ func FromBigFloat(ctx context.Context, typ attr.Type, val *big.Float, path path.
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, val)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfNum, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfNum, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
@@ -358,12 +350,10 @@ func FromBigInt(ctx context.Context, typ attr.Type, val *big.Int, path path.Path
 	}
 	tfNum := tftypes.NewValue(tftypes.Number, fl)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfNum, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfNum, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	num, err := typ.ValueFromTerraform(ctx, tfNum)
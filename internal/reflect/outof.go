@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -18,10 +19,15 @@ import (
 // FromValue is the inverse of Into, taking a Go value (`val`) and transforming it
 // into an attr.Value using the attr.Type supplied. `val` will first be
 // transformed into a tftypes.Value, then passed to `typ`'s ValueFromTerraform
-// method.
+// method. The value is validated as created by the provider, rather than
+// received from Terraform.
 func FromValue(ctx context.Context, typ attr.Type, val interface{}, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
+	if !fwcontext.IsProviderValue(ctx) {
+		ctx = fwcontext.WithProviderValue(ctx)
+	}
+
 	if v, ok := val.(attr.Value); ok {
 		return FromAttributeValue(ctx, typ, v, path)
 	}
@@ -9,8 +9,8 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -93,12 +93,10 @@ func FromPointer(ctx context.Context, typ attr.Type, value reflect.Value, path p
 	if value.IsNil() {
 		tfVal := tftypes.NewValue(typ.TerraformType(ctx), nil)
 
-		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -9,8 +9,8 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -116,12 +116,10 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 	if val.IsNil() {
 		tfVal := tftypes.NewValue(tfType, nil)
 
-		if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+		diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-			if diags.HasError() {
-				return nil, diags
-			}
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
@@ -175,11 +173,10 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 			valPath = path.AtSetValue(val)
 		}
 
-		if typeWithValidate, ok := elemType.(xattr.TypeWithValidate); ok {
-			diags.Append(typeWithValidate.Validate(ctx, tfVal, valPath)...)
-			if diags.HasError() {
-				return nil, diags
-			}
+		diags.Append(fwtype.Validate(ctx, elemType, tfVal, valPath)...)
+
+		if diags.HasError() {
+			return nil, diags
 		}
 
 		tfElems = append(tfElems, tfVal)
@@ -192,12 +189,10 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.
 
 	tfVal := tftypes.NewValue(tfType, tfElems)
 
-	if typeWithValidate, ok := typ.(xattr.TypeWithValidate); ok {
-		diags.Append(typeWithValidate.Validate(ctx, tfVal, path)...)
+	diags.Append(fwtype.Validate(ctx, typ, tfVal, path)...)
 
-		if diags.HasError() {
-			return nil, diags
-		}
+	if diags.HasError() {
+		return nil, diags
 	}
 
 	attrVal, err := typ.ValueFromTerraform(ctx, tfVal)
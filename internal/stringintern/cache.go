@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringintern
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Cache is a least recently used cache of interned strings. Interning a
+// string returns a previously seen, equal string when one is cached, so
+// repeated values share the same backing storage and the duplicate can be
+// garbage collected. Go strings are immutable, so sharing storage does not
+// affect correctness.
+//
+// A nil Cache is valid and does not intern strings. A Cache is safe for
+// concurrent use.
+type Cache struct {
+	maxSize int
+
+	// entries maps each cached string to its element in order.
+	entries map[string]*list.Element
+
+	// order contains the cached strings, with the most recently used first.
+	order *list.List
+
+	mu sync.Mutex
+}
+
+// New returns a Cache which holds at most maxSize strings, evicting the
+// least recently used string when full. A maxSize of zero or less returns
+// nil, which disables interning.
+func New(maxSize int) *Cache {
+	if maxSize <= 0 {
+		return nil
+	}
+
+	return &Cache{
+		maxSize: maxSize,
+		entries: make(map[string]*list.Element, maxSize),
+		order:   list.New(),
+	}
+}
+
+// Intern returns the cached string equal to s, if any, otherwise s is cached
+// and returned.
+func (c *Cache) Intern(s string) string {
+	if c == nil {
+		return s
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[s]; ok {
+		c.order.MoveToFront(element)
+
+		//nolint:forcetypeassert // Only strings are stored in order.
+		return element.Value.(string)
+	}
+
+	if c.order.Len() >= c.maxSize {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+
+		//nolint:forcetypeassert // Only strings are stored in order.
+		delete(c.entries, oldest.Value.(string))
+	}
+
+	c.entries[s] = c.order.PushFront(s)
+
+	return s
+}
+
+// Len returns the number of cached strings.
+func (c *Cache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
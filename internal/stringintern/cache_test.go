@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stringintern_test
+
+import (
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/stringintern"
+)
+
+func TestCacheIntern(t *testing.T) {
+	t.Parallel()
+
+	cache := stringintern.New(2)
+
+	first := string([]byte("value"))
+	second := string([]byte("value"))
+
+	if got := cache.Intern(first); stringData(got) != stringData(first) {
+		t.Errorf("expected first string to be cached and returned")
+	}
+
+	if got := cache.Intern(second); stringData(got) != stringData(first) {
+		t.Errorf("expected equal string to return cached storage")
+	}
+
+	if got := cache.Len(); got != 1 {
+		t.Errorf("expected 1 cached string, got %d", got)
+	}
+}
+
+func TestCacheInternEviction(t *testing.T) {
+	t.Parallel()
+
+	cache := stringintern.New(2)
+
+	a := string([]byte("a"))
+
+	cache.Intern(a)
+	cache.Intern("b")
+	cache.Intern(a) // a is now the most recently used
+	cache.Intern("c")
+
+	if got := cache.Len(); got != 2 {
+		t.Errorf("expected 2 cached strings, got %d", got)
+	}
+
+	if got := cache.Intern(string([]byte("a"))); stringData(got) != stringData(a) {
+		t.Errorf("expected recently used string to remain cached")
+	}
+
+	b := string([]byte("b"))
+
+	if got := cache.Intern(b); stringData(got) != stringData(b) {
+		t.Errorf("expected least recently used string to be evicted")
+	}
+}
+
+func TestCacheInternNil(t *testing.T) {
+	t.Parallel()
+
+	var cache *stringintern.Cache
+
+	if got := cache.Intern("value"); got != "value" {
+		t.Errorf("expected %q, got %q", "value", got)
+	}
+
+	if got := cache.Len(); got != 0 {
+		t.Errorf("expected 0 cached strings, got %d", got)
+	}
+
+	if got := stringintern.New(0); got != nil {
+		t.Errorf("expected nil cache for zero size")
+	}
+}
+
+// stringData returns the address of the backing storage of s.
+func stringData(s string) uintptr {
+	//nolint:staticcheck // unsafe.StringData requires Go 1.20.
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
@@ -0,0 +1,6 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package stringintern contains a bounded cache for sharing the storage of
+// repeated string values.
+package stringintern
@@ -4,6 +4,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 )
@@ -23,3 +25,11 @@ func TestWarningDiagnostic(path path.Path) diag.DiagnosticWithPath {
 		"This is a warning.",
 	)
 }
+
+func TestDuplicateNameDiagnostic(path path.Path, name string, firstIndex int) diag.DiagnosticWithPath {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Duplicate Name",
+		fmt.Sprintf("The name %q is already used by the element at index %d.", name, firstIndex),
+	)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ xattr.TypeWithValidateCollection = ListTypeWithUniqueNames{}
+
+// ListTypeWithUniqueNames is a list of objects type which requires the
+// "name" attribute of each element to be unique across all elements.
+type ListTypeWithUniqueNames struct {
+	types.ListType
+}
+
+func (t ListTypeWithUniqueNames) ValidateCollection(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var elems []tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddAttributeError(path, "List Collection Validation Error", err.Error())
+		return diags
+	}
+
+	seen := make(map[string]int, len(elems))
+
+	for index, elem := range elems {
+		var attrs map[string]tftypes.Value
+
+		if err := elem.As(&attrs); err != nil {
+			diags.AddAttributeError(path.AtListIndex(index), "List Collection Validation Error", err.Error())
+			continue
+		}
+
+		name, ok := attrs["name"]
+
+		if !ok || !name.IsKnown() || name.IsNull() {
+			continue
+		}
+
+		var nameValue string
+
+		if err := name.As(&nameValue); err != nil {
+			diags.AddAttributeError(path.AtListIndex(index), "List Collection Validation Error", err.Error())
+			continue
+		}
+
+		if firstIndex, ok := seen[nameValue]; ok {
+			diags.Append(TestDuplicateNameDiagnostic(path.AtListIndex(index), nameValue, firstIndex))
+			continue
+		}
+
+		seen[nameValue] = index
+	}
+
+	return diags
+}
@@ -475,6 +475,36 @@ func TestExpressionMatches(t *testing.T) {
 			path:       path.Root("test").AtSetValue(types.StringValue("test-value")),
 			expected:   true,
 		},
+		"AttributeNameExact-ElementKeyIntAny-AttributeNameExact-ElementKeyStringAny-equal": {
+			expression: path.MatchRoot("test1").AtAnyListIndex().AtName("test2").AtAnyMapKey(),
+			path:       path.Root("test1").AtListIndex(3).AtName("test2").AtMapKey("test-key"),
+			expected:   true,
+		},
+		"AttributeNameExact-ElementKeyIntAny-AttributeNameExact-ElementKeyStringAny-different": {
+			expression: path.MatchRoot("test1").AtAnyListIndex().AtName("test2").AtAnyMapKey(),
+			path:       path.Root("test1").AtListIndex(3).AtName("test3").AtMapKey("test-key"),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementKeyValueAny-AttributeNameExact-equal": {
+			expression: path.MatchRoot("test1").AtAnySetValue().AtName("test2"),
+			path:       path.Root("test1").AtSetValue(types.StringValue("test-value")).AtName("test2"),
+			expected:   true,
+		},
+		"AttributeNameExact-ElementKeyIntAny-wrong-step-type": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			path:       path.Root("test").AtMapKey("test-key"),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementKeyIntAny-AttributeNameExact-shorter-path": {
+			expression: path.MatchRoot("test1").AtAnyListIndex().AtName("test2"),
+			path:       path.Root("test1").AtListIndex(0),
+			expected:   false,
+		},
+		"AttributeNameExact-ElementKeyIntAny-longer-path": {
+			expression: path.MatchRoot("test1").AtAnyListIndex(),
+			path:       path.Root("test1").AtListIndex(0).AtName("test2"),
+			expected:   false,
+		},
 		"AttributeNameExact-Parent-AttributeNameExact-different": {
 			expression: path.MatchRoot("test1").AtParent().AtName("test2"),
 			path:       path.Root("test1"),
@@ -4,6 +4,8 @@
 package path
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 )
 
@@ -40,6 +42,17 @@ type Path struct {
 	steps PathSteps
 }
 
+// Append returns a copied path with the steps of the given suffix path at the
+// end. The returned path is safe to modify without affecting the original or
+// the suffix.
+func (p Path) Append(suffix Path) Path {
+	copiedPath := p.Copy()
+
+	copiedPath.steps.Append(suffix.Steps()...)
+
+	return copiedPath
+}
+
 // AtListIndex returns a copied path with a new list index step at the end.
 // The returned path is safe to modify without affecting the original.
 //
@@ -90,21 +103,10 @@ func (p Path) Copy() Path {
 	}
 }
 
-// Equal returns true if the given path is exactly equivalent.
+// Equal returns true if the given path is exactly equivalent. A zero-value
+// Path and an Empty path are equivalent.
 func (p Path) Equal(o Path) bool {
-	if p.steps == nil && o.steps == nil {
-		return true
-	}
-
-	if p.steps == nil {
-		return false
-	}
-
-	if !p.steps.Equal(o.steps) {
-		return false
-	}
-
-	return true
+	return p.steps.Equal(o.steps)
 }
 
 // Expression returns an Expression which exactly matches the Path.
@@ -115,6 +117,16 @@ func (p Path) Expression() Expression {
 	}
 }
 
+// LastStep returns the final step of the path and true. If the path is
+// empty, such as the zero-value or Empty(), nil and false are returned.
+func (p Path) LastStep() (PathStep, bool) {
+	if len(p.steps) == 0 {
+		return nil, false
+	}
+
+	return p.steps[len(p.steps)-1], true
+}
+
 // ParentPath returns a copy of the path with the last step removed.
 //
 // If the current path is empty, an empty path is returned.
@@ -130,6 +142,58 @@ func (p Path) ParentPath() Path {
 	}
 }
 
+// ParentN returns a copy of the path with the last n steps removed. For
+// example, ParentN(1).AtName("sibling") returns the path of a sibling
+// attribute.
+//
+// If n is greater than or equal to the number of steps, an empty path is
+// returned. If n is zero or negative, a copy of the path is returned.
+func (p Path) ParentN(n int) Path {
+	if n <= 0 {
+		return p.Copy()
+	}
+
+	if n >= len(p.steps) {
+		return Empty()
+	}
+
+	return Path{
+		steps: p.steps[:len(p.steps)-n].Copy(),
+	}
+}
+
+// Sibling returns a copy of the path with the final attribute name step
+// replaced by `name`, which is the path of the attribute named `name` at the
+// same level. For example, Root("parent").AtName("a").Sibling("b") returns
+// the path parent.b.
+//
+// If the final step is not an attribute name step, such as a list index or
+// map key, or the path is empty, a copy of the path is returned with an
+// error describing the path, which is suitable for diagnostics.
+func (p Path) Sibling(name string) (Path, error) {
+	lastStep, ok := p.LastStep()
+
+	if !ok {
+		return p.Copy(), fmt.Errorf("cannot resolve sibling attribute %q of an empty path", name)
+	}
+
+	if !IsAttributeNameStep(lastStep) {
+		return p.Copy(), fmt.Errorf("cannot resolve sibling attribute %q of path %s, which does not end in an attribute name", name, p)
+	}
+
+	return p.ParentN(1).AtName(name), nil
+}
+
+// StartsWith returns true if the path steps begin with all of the given
+// path steps. Every path starts with an empty path.
+func (p Path) StartsWith(o Path) bool {
+	if len(o.steps) > len(p.steps) {
+		return false
+	}
+
+	return p.steps[:len(o.steps)].Equal(o.steps)
+}
+
 // Steps returns a copy of the underlying path steps. Returns an empty
 // collection of steps if path is nil.
 func (p Path) Steps() PathSteps {
@@ -143,6 +207,10 @@ func (p Path) Steps() PathSteps {
 // String returns the human-readable representation of the path.
 // It is intended for logging and error messages and is not protected by
 // compatibility guarantees.
+//
+// Equal paths return the same string, regardless of how they were built,
+// except for set value steps whose value renders equal values differently,
+// such as a set value containing sets built in a different element order.
 func (p Path) String() string {
 	return p.steps.String()
 }
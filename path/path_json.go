@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	// pathStepJSONAttribute is the JSON object key of PathStepAttributeName.
+	pathStepJSONAttribute = "attribute"
+
+	// pathStepJSONListIndex is the JSON object key of PathStepElementKeyInt.
+	pathStepJSONListIndex = "list_index"
+
+	// pathStepJSONMapKey is the JSON object key of PathStepElementKeyString.
+	pathStepJSONMapKey = "map_key"
+
+	// pathStepJSONSetValue is the JSON object key of PathStepElementKeyValue.
+	pathStepJSONSetValue = "set_value"
+)
+
+// MarshalJSON returns the path as a JSON array with an object for each step,
+// such as [{"attribute":"foo"},{"list_index":3},{"map_key":"bar"}], which is
+// intended for structured logging. Set value steps are represented by the
+// string form of the value, such as {"set_value":"\"baz\""}. Empty paths are
+// represented as an empty array.
+func (p Path) MarshalJSON() ([]byte, error) {
+	steps := make([]map[string]any, 0, len(p.steps))
+
+	for _, step := range p.steps {
+		switch s := step.(type) {
+		case PathStepAttributeName:
+			steps = append(steps, map[string]any{pathStepJSONAttribute: string(s)})
+		case PathStepElementKeyInt:
+			steps = append(steps, map[string]any{pathStepJSONListIndex: int64(s)})
+		case PathStepElementKeyString:
+			steps = append(steps, map[string]any{pathStepJSONMapKey: string(s)})
+		case PathStepElementKeyValue:
+			steps = append(steps, map[string]any{pathStepJSONSetValue: fmt.Sprint(s.Value)})
+		default:
+			return nil, fmt.Errorf("unable to marshal path step %T to JSON", step)
+		}
+	}
+
+	return json.Marshal(steps)
+}
+
+// UnmarshalJSON sets the path from the JSON format of MarshalJSON. Set value
+// steps cannot be unmarshaled, since only the string form of the value is
+// available, and return an error.
+func (p *Path) UnmarshalJSON(data []byte) error {
+	var jsonSteps []map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &jsonSteps); err != nil {
+		return fmt.Errorf("unable to unmarshal path JSON: %w", err)
+	}
+
+	steps := make(PathSteps, 0, len(jsonSteps))
+
+	for index, jsonStep := range jsonSteps {
+		step, err := pathStepFromJSON(jsonStep)
+
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal path JSON step %d: %w", index, err)
+		}
+
+		steps = append(steps, step)
+	}
+
+	p.steps = steps
+
+	return nil
+}
+
+// pathStepFromJSON returns the PathStep of a JSON step object, which must
+// contain exactly one key.
+func pathStepFromJSON(jsonStep map[string]json.RawMessage) (PathStep, error) {
+	if len(jsonStep) != 1 {
+		return nil, fmt.Errorf("expected object with one key, got %d keys", len(jsonStep))
+	}
+
+	for key, value := range jsonStep {
+		switch key {
+		case pathStepJSONAttribute:
+			var name string
+
+			if err := json.Unmarshal(value, &name); err != nil {
+				return nil, err
+			}
+
+			return PathStepAttributeName(name), nil
+		case pathStepJSONListIndex:
+			var index int64
+
+			if err := json.Unmarshal(value, &index); err != nil {
+				return nil, err
+			}
+
+			return PathStepElementKeyInt(index), nil
+		case pathStepJSONMapKey:
+			var mapKey string
+
+			if err := json.Unmarshal(value, &mapKey); err != nil {
+				return nil, err
+			}
+
+			return PathStepElementKeyString(mapKey), nil
+		case pathStepJSONSetValue:
+			return nil, errors.New("set value steps cannot be unmarshaled")
+		default:
+			return nil, fmt.Errorf("unexpected key %q", key)
+		}
+	}
+
+	return nil, errors.New("expected object with one key")
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPathMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected string
+	}{
+		"zero-value": {
+			path:     path.Path{},
+			expected: `[]`,
+		},
+		"empty": {
+			path:     path.Empty(),
+			expected: `[]`,
+		},
+		"AttributeName": {
+			path:     path.Root("test"),
+			expected: `[{"attribute":"test"}]`,
+		},
+		"AttributeName-ElementKeyInt": {
+			path:     path.Root("test").AtListIndex(3),
+			expected: `[{"attribute":"test"},{"list_index":3}]`,
+		},
+		"AttributeName-ElementKeyString": {
+			path:     path.Root("test").AtMapKey("key"),
+			expected: `[{"attribute":"test"},{"map_key":"key"}]`,
+		},
+		"AttributeName-ElementKeyValue": {
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: `[{"attribute":"test"},{"set_value":"\"value\""}]`,
+		},
+		"mixed": {
+			path:     path.Root("test").AtListIndex(0).AtMapKey("key").AtName("nested").AtSetValue(types.Int64Value(1)),
+			expected: `[{"attribute":"test"},{"list_index":0},{"map_key":"key"},{"attribute":"nested"},{"set_value":"1"}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(testCase.path)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Path
+		expectedError string // prefix, as encoding/json messages vary by Go version
+	}{
+		"empty": {
+			input:    `[]`,
+			expected: path.Empty(),
+		},
+		"null": {
+			input:    `null`,
+			expected: path.Empty(),
+		},
+		"AttributeName": {
+			input:    `[{"attribute":"test"}]`,
+			expected: path.Root("test"),
+		},
+		"AttributeName-ElementKeyInt": {
+			input:    `[{"attribute":"test"},{"list_index":3}]`,
+			expected: path.Root("test").AtListIndex(3),
+		},
+		"AttributeName-ElementKeyString": {
+			input:    `[{"attribute":"test"},{"map_key":"key"}]`,
+			expected: path.Root("test").AtMapKey("key"),
+		},
+		"mixed": {
+			input:    `[{"attribute":"test"},{"list_index":0},{"map_key":"key"},{"attribute":"nested"}]`,
+			expected: path.Root("test").AtListIndex(0).AtMapKey("key").AtName("nested"),
+		},
+		"ElementKeyValue": {
+			input:         `[{"attribute":"test"},{"set_value":"\"value\""}]`,
+			expectedError: "unable to unmarshal path JSON step 1: set value steps cannot be unmarshaled",
+		},
+		"invalid-json": {
+			input:         `{"attribute":"test"}`,
+			expectedError: "unable to unmarshal path JSON: json: cannot unmarshal object",
+		},
+		"invalid-step-keys": {
+			input:         `[{"attribute":"test","list_index":3}]`,
+			expectedError: "unable to unmarshal path JSON step 0: expected object with one key, got 2 keys",
+		},
+		"invalid-step-key": {
+			input:         `[{"unknown":"test"}]`,
+			expectedError: `unable to unmarshal path JSON step 0: unexpected key "unknown"`,
+		},
+		"invalid-step-value": {
+			input:         `[{"list_index":"3"}]`,
+			expectedError: "unable to unmarshal path JSON step 0: json: cannot unmarshal string into Go value of type int64",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got path.Path
+
+			err := json.Unmarshal([]byte(testCase.input), &got)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestPathJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]path.Path{
+		"empty":                          path.Empty(),
+		"AttributeName":                  path.Root("test"),
+		"AttributeName-ElementKeyInt":    path.Root("test").AtListIndex(3),
+		"AttributeName-ElementKeyString": path.Root("test").AtMapKey(""),
+		"mixed":                          path.Root("a").AtListIndex(1).AtName("b").AtMapKey("c").AtListIndex(2).AtName("d"),
+	}
+
+	for name, input := range testCases {
+		name, input := name, input
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(input)
+
+			if err != nil {
+				t.Fatalf("unexpected marshal error: %s", err)
+			}
+
+			var got path.Path
+
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("unexpected unmarshal error: %s", err)
+			}
+
+			if !got.Equal(input) {
+				t.Errorf("expected %s, got: %s", input, got)
+			}
+		})
+	}
+}
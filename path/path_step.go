@@ -22,3 +22,34 @@ type PathStep interface {
 	// unexported prevents outside types from satisfying the interface.
 	unexported()
 }
+
+// IsAttributeNameStep returns true if the PathStep is a PathStepAttributeName.
+func IsAttributeNameStep(step PathStep) bool {
+	_, ok := step.(PathStepAttributeName)
+
+	return ok
+}
+
+// IsElementKeyIntStep returns true if the PathStep is a
+// PathStepElementKeyInt, such as a list index.
+func IsElementKeyIntStep(step PathStep) bool {
+	_, ok := step.(PathStepElementKeyInt)
+
+	return ok
+}
+
+// IsElementKeyStringStep returns true if the PathStep is a
+// PathStepElementKeyString, such as a map key.
+func IsElementKeyStringStep(step PathStep) bool {
+	_, ok := step.(PathStepElementKeyString)
+
+	return ok
+}
+
+// IsElementKeyValueStep returns true if the PathStep is a
+// PathStepElementKeyValue, such as a set value.
+func IsElementKeyValueStep(step PathStep) bool {
+	_, ok := step.(PathStepElementKeyValue)
+
+	return ok
+}
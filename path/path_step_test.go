@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPathStepKind(t *testing.T) {
+	t.Parallel()
+
+	type stepKind struct {
+		AttributeName    bool
+		ElementKeyInt    bool
+		ElementKeyString bool
+		ElementKeyValue  bool
+	}
+
+	testCases := map[string]struct {
+		step     path.PathStep
+		expected stepKind
+	}{
+		"nil": {
+			step:     nil,
+			expected: stepKind{},
+		},
+		"PathStepAttributeName": {
+			step: path.PathStepAttributeName("test"),
+			expected: stepKind{
+				AttributeName: true,
+			},
+		},
+		"PathStepElementKeyInt": {
+			step: path.PathStepElementKeyInt(3),
+			expected: stepKind{
+				ElementKeyInt: true,
+			},
+		},
+		"PathStepElementKeyString": {
+			step: path.PathStepElementKeyString("foo"),
+			expected: stepKind{
+				ElementKeyString: true,
+			},
+		},
+		"PathStepElementKeyValue": {
+			step: path.PathStepElementKeyValue{Value: types.StringValue("foo")},
+			expected: stepKind{
+				ElementKeyValue: true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := stepKind{
+				AttributeName:    path.IsAttributeNameStep(testCase.step),
+				ElementKeyInt:    path.IsElementKeyIntStep(testCase.step),
+				ElementKeyString: path.IsElementKeyStringStep(testCase.step),
+				ElementKeyValue:  path.IsElementKeyValueStep(testCase.step),
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -12,6 +12,72 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+func TestPathAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		suffix   path.Path
+		expected path.Path
+	}{
+		"empty": {
+			path:     path.Empty(),
+			suffix:   path.Root("test").AtListIndex(0),
+			expected: path.Root("test").AtListIndex(0),
+		},
+		"root": {
+			path:     path.Root("test1"),
+			suffix:   path.Root("test2").AtMapKey("key"),
+			expected: path.Root("test1").AtName("test2").AtMapKey("key"),
+		},
+		"deep": {
+			path:     path.Root("test1").AtListIndex(0).AtName("test2"),
+			suffix:   path.Root("test3").AtSetValue(types.StringValue("test")),
+			expected: path.Root("test1").AtListIndex(0).AtName("test2").AtName("test3").AtSetValue(types.StringValue("test")),
+		},
+		"suffix-empty": {
+			path:     path.Root("test1").AtListIndex(0),
+			suffix:   path.Empty(),
+			expected: path.Root("test1").AtListIndex(0),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.path.Append(testCase.suffix)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s to equal %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPathAppend_immutable(t *testing.T) {
+	t.Parallel()
+
+	base := path.Root("test1").AtListIndex(0)
+	suffix := path.Root("test2")
+
+	_ = base.Append(suffix).AtName("test3")
+
+	if diff := cmp.Diff(base, path.Root("test1").AtListIndex(0)); diff != "" {
+		t.Errorf("unexpected base difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(suffix, path.Root("test2")); diff != "" {
+		t.Errorf("unexpected suffix difference: %s", diff)
+	}
+}
+
 func TestPathAtListIndex(t *testing.T) {
 	t.Parallel()
 
@@ -221,6 +287,21 @@ func TestPathEqual(t *testing.T) {
 			other:    path.Empty(),
 			expected: true,
 		},
+		"empty-zero-value": {
+			path:     path.Empty(),
+			other:    path.Path{},
+			expected: true,
+		},
+		"zero-value-empty": {
+			path:     path.Path{},
+			other:    path.Empty(),
+			expected: true,
+		},
+		"zero-value-root": {
+			path:     path.Path{},
+			other:    path.Root("test"),
+			expected: false,
+		},
 		"different-length": {
 			path:     path.Root("test1").AtName("test2"),
 			other:    path.Root("test1"),
@@ -263,6 +344,90 @@ func TestPathEqual(t *testing.T) {
 	}
 }
 
+func TestPathEqualConstruction(t *testing.T) {
+	t.Parallel()
+
+	// Paths sharing a parent must not share underlying steps.
+	base := path.Root("test").AtListIndex(0)
+	first := base.AtName("first")
+	second := base.AtName("second")
+
+	testCases := map[string]struct {
+		path     path.Path
+		other    path.Path
+		expected string
+	}{
+		"root-list-index-name": {
+			path:     path.Root("test").AtListIndex(0).AtName("x"),
+			other:    path.Empty().AtName("test").AtListIndex(0).AtName("x"),
+			expected: "test[0].x",
+		},
+		"shared-parent-first": {
+			path:     first,
+			other:    path.Root("test").AtListIndex(0).AtName("first"),
+			expected: "test[0].first",
+		},
+		"shared-parent-second": {
+			path:     second,
+			other:    path.Root("test").AtListIndex(0).AtName("second"),
+			expected: "test[0].second",
+		},
+		"parent-path": {
+			path:     first.ParentPath().AtName("x"),
+			other:    path.Root("test").AtListIndex(0).AtName("x"),
+			expected: "test[0].x",
+		},
+		"set-value-string": {
+			path:     path.Root("test").AtSetValue(types.StringValue("value")),
+			other:    path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: `test[Value("value")]`,
+		},
+		"set-value-object": {
+			path: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"a": types.StringType,
+					"b": types.StringType,
+				},
+				map[string]attr.Value{
+					"a": types.StringValue("one"),
+					"b": types.StringValue("two"),
+				},
+			)),
+			other: path.Root("test").AtSetValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"b": types.StringType,
+					"a": types.StringType,
+				},
+				map[string]attr.Value{
+					"b": types.StringValue("two"),
+					"a": types.StringValue("one"),
+				},
+			)),
+			expected: `test[Value({"a":"one","b":"two"})]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if !testCase.path.Equal(testCase.other) {
+				t.Errorf("expected %s to equal %s", testCase.path, testCase.other)
+			}
+
+			if got := testCase.path.String(); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+
+			if got := testCase.other.String(); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestPathExpression(t *testing.T) {
 	t.Parallel()
 
@@ -295,6 +460,70 @@ func TestPathExpression(t *testing.T) {
 	}
 }
 
+func TestPathLastStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path         path.Path
+		expectedStep path.PathStep
+		expectedOk   bool
+	}{
+		"zero-value": {
+			path:         path.Path{},
+			expectedStep: nil,
+			expectedOk:   false,
+		},
+		"empty": {
+			path:         path.Empty(),
+			expectedStep: nil,
+			expectedOk:   false,
+		},
+		"AttributeName": {
+			path:         path.Root("test"),
+			expectedStep: path.PathStepAttributeName("test"),
+			expectedOk:   true,
+		},
+		"AttributeName-AttributeName": {
+			path:         path.Root("test").AtName("nested"),
+			expectedStep: path.PathStepAttributeName("nested"),
+			expectedOk:   true,
+		},
+		"AttributeName-ElementKeyInt": {
+			path:         path.Root("test").AtListIndex(3),
+			expectedStep: path.PathStepElementKeyInt(3),
+			expectedOk:   true,
+		},
+		"AttributeName-ElementKeyString": {
+			path:         path.Root("test").AtMapKey("foo"),
+			expectedStep: path.PathStepElementKeyString("foo"),
+			expectedOk:   true,
+		},
+		"AttributeName-ElementKeyValue": {
+			path:         path.Root("test").AtSetValue(types.StringValue("foo")),
+			expectedStep: path.PathStepElementKeyValue{Value: types.StringValue("foo")},
+			expectedOk:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotStep, gotOk := testCase.path.LastStep()
+
+			if diff := cmp.Diff(gotStep, testCase.expectedStep); diff != "" {
+				t.Errorf("unexpected step difference: %s", diff)
+			}
+
+			if gotOk != testCase.expectedOk {
+				t.Errorf("expected ok %t, got: %t", testCase.expectedOk, gotOk)
+			}
+		})
+	}
+}
+
 func TestPathParentPath(t *testing.T) {
 	t.Parallel()
 
@@ -331,6 +560,241 @@ func TestPathParentPath(t *testing.T) {
 	}
 }
 
+func TestPathParentN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		n        int
+		expected path.Path
+	}{
+		"empty": {
+			path:     path.Empty(),
+			n:        1,
+			expected: path.Empty(),
+		},
+		"zero": {
+			path:     path.Root("test").AtListIndex(1),
+			n:        0,
+			expected: path.Root("test").AtListIndex(1),
+		},
+		"negative": {
+			path:     path.Root("test").AtListIndex(1),
+			n:        -1,
+			expected: path.Root("test").AtListIndex(1),
+		},
+		"one": {
+			path:     path.Root("test").AtListIndex(1).AtName("nested"),
+			n:        1,
+			expected: path.Root("test").AtListIndex(1),
+		},
+		"two": {
+			path:     path.Root("test").AtMapKey("key").AtName("nested"),
+			n:        2,
+			expected: path.Root("test"),
+		},
+		"set-value": {
+			path:     path.Root("test").AtSetValue(types.StringValue("value")).AtName("nested"),
+			n:        1,
+			expected: path.Root("test").AtSetValue(types.StringValue("value")),
+		},
+		"equal-depth": {
+			path:     path.Root("test").AtListIndex(1),
+			n:        2,
+			expected: path.Empty(),
+		},
+		"greater-depth": {
+			path:     path.Root("test").AtListIndex(1),
+			n:        3,
+			expected: path.Empty(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.path.ParentN(testCase.n)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathParentNSibling(t *testing.T) {
+	t.Parallel()
+
+	got := path.Root("test").AtListIndex(0).AtName("attr").ParentN(1).AtName("sibling")
+	expected := path.Root("test").AtListIndex(0).AtName("sibling")
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestPathSibling(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path          path.Path
+		name          string
+		expected      path.Path
+		expectedError string
+	}{
+		"empty": {
+			path:          path.Empty(),
+			name:          "sibling",
+			expected:      path.Empty(),
+			expectedError: `cannot resolve sibling attribute "sibling" of an empty path`,
+		},
+		"root": {
+			path:     path.Root("test"),
+			name:     "sibling",
+			expected: path.Root("sibling"),
+		},
+		"nested": {
+			path:     path.Root("test").AtListIndex(0).AtName("attr"),
+			name:     "sibling",
+			expected: path.Root("test").AtListIndex(0).AtName("sibling"),
+		},
+		"list-index": {
+			path:          path.Root("test").AtListIndex(0),
+			name:          "sibling",
+			expected:      path.Root("test").AtListIndex(0),
+			expectedError: `cannot resolve sibling attribute "sibling" of path test[0], which does not end in an attribute name`,
+		},
+		"map-key": {
+			path:          path.Root("test").AtMapKey("key"),
+			name:          "sibling",
+			expected:      path.Root("test").AtMapKey("key"),
+			expectedError: `cannot resolve sibling attribute "sibling" of path test["key"], which does not end in an attribute name`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.path.Sibling(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			var gotError string
+
+			if err != nil {
+				gotError = err.Error()
+			}
+
+			if gotError != testCase.expectedError {
+				t.Errorf("expected error %q, got %q", testCase.expectedError, gotError)
+			}
+		})
+	}
+}
+
+func TestPathStartsWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		other    path.Path
+		expected bool
+	}{
+		"empty-empty": {
+			path:     path.Empty(),
+			other:    path.Empty(),
+			expected: true,
+		},
+		"empty-other": {
+			path:     path.Empty(),
+			other:    path.Root("test"),
+			expected: false,
+		},
+		"other-empty": {
+			path:     path.Root("test"),
+			other:    path.Empty(),
+			expected: true,
+		},
+		"attribute-name-equal": {
+			path:     path.Root("test"),
+			other:    path.Root("test"),
+			expected: true,
+		},
+		"attribute-name-different": {
+			path:     path.Root("test"),
+			other:    path.Root("other"),
+			expected: false,
+		},
+		"attribute-name-prefix": {
+			path:     path.Root("test").AtName("nested"),
+			other:    path.Root("test"),
+			expected: true,
+		},
+		"attribute-name-longer-other": {
+			path:     path.Root("test"),
+			other:    path.Root("test").AtName("nested"),
+			expected: false,
+		},
+		"list-index-prefix": {
+			path:     path.Root("test").AtListIndex(1).AtName("nested"),
+			other:    path.Root("test").AtListIndex(1),
+			expected: true,
+		},
+		"list-index-different": {
+			path:     path.Root("test").AtListIndex(1).AtName("nested"),
+			other:    path.Root("test").AtListIndex(0),
+			expected: false,
+		},
+		"map-key-prefix": {
+			path:     path.Root("test").AtMapKey("key").AtName("nested"),
+			other:    path.Root("test").AtMapKey("key"),
+			expected: true,
+		},
+		"map-key-different": {
+			path:     path.Root("test").AtMapKey("key").AtName("nested"),
+			other:    path.Root("test").AtMapKey("other"),
+			expected: false,
+		},
+		"set-value-prefix": {
+			path:     path.Root("test").AtSetValue(types.StringValue("value")).AtName("nested"),
+			other:    path.Root("test").AtSetValue(types.StringValue("value")),
+			expected: true,
+		},
+		"set-value-different": {
+			path:     path.Root("test").AtSetValue(types.StringValue("value")).AtName("nested"),
+			other:    path.Root("test").AtSetValue(types.StringValue("other")),
+			expected: false,
+		},
+		"step-type-different": {
+			path:     path.Root("test").AtListIndex(0),
+			other:    path.Root("test").AtMapKey("0"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.path.StartsWith(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestPathSteps(t *testing.T) {
 	t.Parallel()
 
@@ -126,6 +126,20 @@ func TestPathsContains(t *testing.T) {
 			contains: path.Empty(),
 			expected: false,
 		},
+		"contains-zero-value-empty": {
+			paths: path.Paths{
+				path.Empty(),
+			},
+			contains: path.Path{},
+			expected: true,
+		},
+		"contains-different-construction": {
+			paths: path.Paths{
+				path.Root("test").AtListIndex(0).AtName("test_attr"),
+			},
+			contains: path.Root("test").AtListIndex(0).AtName("other").ParentPath().AtName("test_attr"),
+			expected: true,
+		},
 		"contains-middle": {
 			paths: path.Paths{
 				path.Root("test1").AtName("test1_attr"),
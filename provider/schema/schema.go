@@ -124,6 +124,21 @@ func (s Schema) TypeAtTerraformPath(ctx context.Context, p *tftypes.AttributePat
 	return fwschema.SchemaTypeAtTerraformPath(ctx, s, p)
 }
 
+// WalkTypes calls `fn` with the path and framework type of every attribute
+// and block in the schema, descending into the attribute types of objects
+// and the element types of lists, maps, sets, and tuples. Attributes are
+// visited in name order, with each type visited before its nested types.
+//
+// Element paths use representative steps, since no data is involved: list
+// and tuple elements use list index steps, with list elements always at
+// index 0, map elements use an empty map key, and set elements use a set
+// value step with the element type ValueType value.
+//
+// Walking stops at the first error returned by `fn`, which is returned.
+func (s Schema) WalkTypes(ctx context.Context, fn func(path.Path, attr.Type) error) error {
+	return fwschema.SchemaWalkTypes(ctx, s, fn)
+}
+
 // Validate verifies that the schema is not using a reserved field name for a top-level attribute.
 //
 // Deprecated: Use the ValidateImplementation method instead.
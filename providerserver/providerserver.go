@@ -100,6 +100,9 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 					FrameworkServer: fwserver.Server{
 						Provider: provider,
 					},
+					MaxDynamicValueSize:      opts.MaxDynamicValueSize,
+					MaxDiagnosticValueLength: opts.MaxDiagnosticValueLength,
+					StringInternCacheSize:    opts.StringInternCacheSize,
 				}
 			},
 			tf5serverOpts...,
@@ -120,6 +123,9 @@ func Serve(ctx context.Context, providerFunc func() provider.Provider, opts Serv
 					FrameworkServer: fwserver.Server{
 						Provider: provider,
 					},
+					MaxDynamicValueSize:      opts.MaxDynamicValueSize,
+					MaxDiagnosticValueLength: opts.MaxDiagnosticValueLength,
+					StringInternCacheSize:    opts.StringInternCacheSize,
 				}
 			},
 			tf6serverOpts...,
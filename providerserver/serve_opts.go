@@ -34,6 +34,26 @@ type ServeOpts struct {
 	//     - tfsdk.Attribute cannot use Attributes field (nested attributes).
 	//
 	ProtocolVersion int
+
+	// MaxDynamicValueSize, when greater than zero, is the maximum size in
+	// bytes of configuration, plan, and state data received from Terraform
+	// in a single request to decode. Requests with larger data return an
+	// error diagnostic instead of decoding it, which prevents memory spikes
+	// for unexpectedly large data. By default, the size is unlimited.
+	MaxDynamicValueSize int
+
+	// MaxDiagnosticValueLength, when greater than zero, is the maximum
+	// length in characters of values, such as duplicate set elements,
+	// interpolated into diagnostic details. Longer values are truncated with
+	// an ellipsis. By default, diag.DefaultMaxValueLength is used.
+	MaxDiagnosticValueLength int
+
+	// StringInternCacheSize, when greater than zero, enables interning of
+	// string values converted from Terraform data, such as configuration,
+	// plan, and state, using a least recently used cache of up to this many
+	// distinct strings. Interning lets repeated strings, such as in large
+	// lists, share memory. By default, strings are not interned.
+	StringInternCacheSize int
 }
 
 // Validate a given provider address. This is only used for the Address field
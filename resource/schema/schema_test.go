@@ -1009,6 +1009,151 @@ func TestSchemaTypeAtTerraformPath(t *testing.T) {
 	}
 }
 
+func TestSchemaWalkTypes(t *testing.T) {
+	t.Parallel()
+
+	type visit struct {
+		path path.Path
+		typ  attr.Type
+	}
+
+	nestedObjectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested_bool":   types.BoolType,
+			"nested_string": types.StringType,
+		},
+	}
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected []visit
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			expected: nil,
+		},
+		"attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"string": schema.StringAttribute{},
+					"bool":   schema.BoolAttribute{},
+				},
+			},
+			expected: []visit{
+				{path: path.Root("bool"), typ: types.BoolType},
+				{path: path.Root("string"), typ: types.StringType},
+			},
+		},
+		"list-nested-attribute": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"list": schema.ListNestedAttribute{
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"nested_string": schema.StringAttribute{},
+								"nested_bool":   schema.BoolAttribute{},
+							},
+						},
+					},
+				},
+			},
+			expected: []visit{
+				{path: path.Root("list"), typ: types.ListType{ElemType: nestedObjectType}},
+				{path: path.Root("list").AtListIndex(0), typ: nestedObjectType},
+				{path: path.Root("list").AtListIndex(0).AtName("nested_bool"), typ: types.BoolType},
+				{path: path.Root("list").AtListIndex(0).AtName("nested_string"), typ: types.StringType},
+			},
+		},
+		"map-set-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"map": schema.MapAttribute{ElementType: types.Int64Type},
+					"set": schema.SetAttribute{ElementType: types.StringType},
+				},
+			},
+			expected: []visit{
+				{path: path.Root("map"), typ: types.MapType{ElemType: types.Int64Type}},
+				{path: path.Root("map").AtMapKey(""), typ: types.Int64Type},
+				{path: path.Root("set"), typ: types.SetType{ElemType: types.StringType}},
+				{path: path.Root("set").AtSetValue(types.StringNull()), typ: types.StringType},
+			},
+		},
+		"single-nested-block": {
+			schema: schema.Schema{
+				Blocks: map[string]schema.Block{
+					"block": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"nested_string": schema.StringAttribute{},
+						},
+					},
+				},
+			},
+			expected: []visit{
+				{
+					path: path.Root("block"),
+					typ: types.ObjectType{
+						AttrTypes: map[string]attr.Type{
+							"nested_string": types.StringType,
+						},
+					},
+				},
+				{path: path.Root("block").AtName("nested_string"), typ: types.StringType},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []visit
+
+			err := testCase.schema.WalkTypes(context.Background(), func(p path.Path, typ attr.Type) error {
+				got = append(got, visit{path: p, typ: typ})
+
+				return nil
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(visit{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaWalkTypesError(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"a": schema.StringAttribute{},
+			"b": schema.StringAttribute{},
+		},
+	}
+
+	var got []path.Path
+
+	err := testSchema.WalkTypes(context.Background(), func(p path.Path, _ attr.Type) error {
+		got = append(got, p)
+
+		return fmt.Errorf("test error")
+	})
+
+	if err == nil || err.Error() != "test error" {
+		t.Errorf("expected test error, got: %v", err)
+	}
+
+	if diff := cmp.Diff(got, []path.Path{path.Root("a")}); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestSchemaValidate(t *testing.T) {
 	t.Parallel()
 
@@ -390,3 +390,35 @@ func TestNewBoolPointerValue(t *testing.T) {
 		})
 	}
 }
+
+func TestBoolValuePointerRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input BoolValue
+	}{
+		"false": {
+			input: NewBoolValue(false),
+		},
+		"true": {
+			input: NewBoolValue(true),
+		},
+		"null": {
+			input: NewBoolNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewBoolPointerValue(testCase.input.ValueBoolPointer())
+
+			if diff := cmp.Diff(got, testCase.input); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
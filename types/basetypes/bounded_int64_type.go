@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ xattr.TypeWithValidate = BoundedInt64Type{}
+
+// BoundedInt64Type is an Int64Type which only permits values within the
+// inclusive range of Min to Max. Values are still represented with
+// Int64Value, the range is only enforced by Validate.
+type BoundedInt64Type struct {
+	Int64Type
+
+	// Min is the smallest permitted value.
+	Min int64
+
+	// Max is the largest permitted value.
+	Max int64
+}
+
+// NewBoundedInt64Type returns a BoundedInt64Type which only permits values
+// within the inclusive range of `min` to `max`.
+func NewBoundedInt64Type(min, max int64) BoundedInt64Type {
+	return BoundedInt64Type{
+		Min: min,
+		Max: max,
+	}
+}
+
+// Equal returns true if the given type is also a BoundedInt64Type with the
+// same range.
+func (t BoundedInt64Type) Equal(o attr.Type) bool {
+	other, ok := o.(BoundedInt64Type)
+
+	if !ok {
+		return false
+	}
+
+	return t.Min == other.Min && t.Max == other.Max
+}
+
+// String returns a human readable string of the type name.
+func (t BoundedInt64Type) String() string {
+	return fmt.Sprintf("basetypes.BoundedInt64Type[%d, %d]", t.Min, t.Max)
+}
+
+// Validate returns the Int64Type validation diagnostics, then an error
+// diagnostic if a known value is outside the inclusive range of Min to Max.
+// A Min greater than Max always returns an error diagnostic.
+func (t BoundedInt64Type) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if t.Min > t.Max {
+		diags.AddAttributeError(
+			path,
+			"Bounded Int64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Minimum value %d is greater than maximum value %d.", t.Min, t.Max),
+		)
+
+		return diags
+	}
+
+	diags.Append(t.Int64Type.Validate(ctx, in, path)...)
+
+	if diags.HasError() || in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Bounded Int64 Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+
+		return diags
+	}
+
+	i, _ := value.Int64()
+
+	if i < t.Min || i > t.Max {
+		diags.AddAttributeError(
+			path,
+			"Value Out of Range",
+			fmt.Sprintf("Value must be between %d and %d, got: %d", t.Min, t.Max, i),
+		)
+	}
+
+	return diags
+}
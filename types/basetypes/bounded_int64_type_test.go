@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestBoundedInt64TypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      BoundedInt64Type
+		in       tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"min": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, 1),
+		},
+		"max": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, 10),
+		},
+		"below-min": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, 0),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Out of Range",
+					"Value must be between 1 and 10, got: 0",
+				),
+			},
+		},
+		"above-max": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, 11),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Out of Range",
+					"Value must be between 1 and 10, got: 11",
+				),
+			},
+		},
+		"single-value-range": {
+			typ: NewBoundedInt64Type(-5, -5),
+			in:  tftypes.NewValue(tftypes.Number, -5),
+		},
+		"null": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, nil),
+		},
+		"unknown": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"wrong-value-type": {
+			typ: NewBoundedInt64Type(1, 10),
+			in:  tftypes.NewValue(tftypes.String, "5"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Int64 Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Expected Number value, received tftypes.Value with value: tftypes.String<"5">`,
+				),
+			},
+		},
+		"min-greater-than-max": {
+			typ: NewBoundedInt64Type(10, 1),
+			in:  tftypes.NewValue(tftypes.Number, 5),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Bounded Int64 Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Minimum value 10 is greater than maximum value 1.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.typ.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBoundedInt64TypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	got, err := NewBoundedInt64Type(1, 10).ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, 5))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, NewInt64Value(5)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestBoundedInt64TypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    attr.Type
+		expected bool
+	}{
+		"same-range": {
+			input:    NewBoundedInt64Type(1, 10),
+			expected: true,
+		},
+		"different-range": {
+			input:    NewBoundedInt64Type(1, 11),
+			expected: false,
+		},
+		"int64": {
+			input:    Int64Type{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewBoundedInt64Type(1, 10).Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
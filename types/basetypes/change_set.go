@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ChangeSet is the structured difference between two collection values, as
+// returned by the CollectionChangeSet function.
+type ChangeSet struct {
+	// Additions are elements which are only present in the planned value.
+	Additions []ChangeSetEntry
+
+	// Removals are elements which are only present in the prior value.
+	Removals []ChangeSetEntry
+
+	// Modifications are elements which are present in both values, but are
+	// not equal. Set elements are never modified, only added or removed.
+	Modifications []ChangeSetEntry
+}
+
+// ChangeSetEntry is a single element difference within a ChangeSet.
+type ChangeSetEntry struct {
+	// Path is the element path, relative to the collection, such as
+	// path.Empty().AtListIndex(0).
+	Path path.Path
+
+	// Prior is the element in the prior value. This is nil for additions.
+	Prior attr.Value
+
+	// Planned is the element in the planned value. This is nil for removals.
+	Planned attr.Value
+}
+
+// CollectionChangeSet returns the additions, removals, and modifications of
+// elements between the prior and planned values, which must both be List,
+// Set, or Map values of the same element type. This enables providers to
+// drive individual remote API calls from the difference of a collection, such
+// as adding or removing members, rather than replacing the whole collection.
+//
+// List elements are compared by index, Set elements by equality, and Map
+// elements by key. Changes are returned in index, configuration, or sorted key
+// order respectively. A null value is treated as having no elements. An error
+// diagnostic is returned if either value is unknown.
+func CollectionChangeSet(prior, planned attr.Value) (ChangeSet, diag.Diagnostics) {
+	var changeSet ChangeSet
+	var diags diag.Diagnostics
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	if prior == nil || planned == nil {
+		diags.AddError(
+			"Collection Change Set Error",
+			"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Both the prior and planned values must be given.",
+		)
+
+		return changeSet, diags
+	}
+
+	if prior.IsUnknown() || planned.IsUnknown() {
+		diags.AddError(
+			"Collection Change Set Error",
+			"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Unknown values cannot be compared. Prior: %s, Planned: %s", prior, planned),
+		)
+
+		return changeSet, diags
+	}
+
+	if !prior.Type(ctx).Equal(planned.Type(ctx)) {
+		diags.AddError(
+			"Collection Change Set Error",
+			"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Prior type %s does not match planned type %s.", prior.Type(ctx), planned.Type(ctx)),
+		)
+
+		return changeSet, diags
+	}
+
+	switch priorValue := prior.(type) {
+	case ListValuable:
+		priorList, priorDiags := priorValue.ToListValue(ctx)
+		diags.Append(priorDiags...)
+
+		plannedValue, ok := planned.(ListValuable)
+
+		if !ok {
+			diags.AddError(
+				"Collection Change Set Error",
+				"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Planned value is not a List value, got: %T", planned),
+			)
+
+			return changeSet, diags
+		}
+
+		plannedList, plannedDiags := plannedValue.ToListValue(ctx)
+		diags.Append(plannedDiags...)
+
+		if diags.HasError() {
+			return changeSet, diags
+		}
+
+		priorElems := priorList.Elements()
+		plannedElems := plannedList.Elements()
+
+		for idx := 0; idx < len(priorElems) || idx < len(plannedElems); idx++ {
+			entry := ChangeSetEntry{
+				Path: path.Empty().AtListIndex(idx),
+			}
+
+			switch {
+			case idx >= len(priorElems):
+				entry.Planned = plannedElems[idx]
+				changeSet.Additions = append(changeSet.Additions, entry)
+			case idx >= len(plannedElems):
+				entry.Prior = priorElems[idx]
+				changeSet.Removals = append(changeSet.Removals, entry)
+			case !priorElems[idx].Equal(plannedElems[idx]):
+				entry.Prior = priorElems[idx]
+				entry.Planned = plannedElems[idx]
+				changeSet.Modifications = append(changeSet.Modifications, entry)
+			}
+		}
+	case SetValuable:
+		priorSet, priorDiags := priorValue.ToSetValue(ctx)
+		diags.Append(priorDiags...)
+
+		plannedValue, ok := planned.(SetValuable)
+
+		if !ok {
+			diags.AddError(
+				"Collection Change Set Error",
+				"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Planned value is not a Set value, got: %T", planned),
+			)
+
+			return changeSet, diags
+		}
+
+		plannedSet, plannedDiags := plannedValue.ToSetValue(ctx)
+		diags.Append(plannedDiags...)
+
+		if diags.HasError() {
+			return changeSet, diags
+		}
+
+		for _, elem := range plannedSet.Elements() {
+			if !priorSet.contains(elem) {
+				changeSet.Additions = append(changeSet.Additions, ChangeSetEntry{
+					Path:    path.Empty().AtSetValue(elem),
+					Planned: elem,
+				})
+			}
+		}
+
+		for _, elem := range priorSet.Elements() {
+			if !plannedSet.contains(elem) {
+				changeSet.Removals = append(changeSet.Removals, ChangeSetEntry{
+					Path:  path.Empty().AtSetValue(elem),
+					Prior: elem,
+				})
+			}
+		}
+	case MapValuable:
+		priorMap, priorDiags := priorValue.ToMapValue(ctx)
+		diags.Append(priorDiags...)
+
+		plannedValue, ok := planned.(MapValuable)
+
+		if !ok {
+			diags.AddError(
+				"Collection Change Set Error",
+				"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Planned value is not a Map value, got: %T", planned),
+			)
+
+			return changeSet, diags
+		}
+
+		plannedMap, plannedDiags := plannedValue.ToMapValue(ctx)
+		diags.Append(plannedDiags...)
+
+		if diags.HasError() {
+			return changeSet, diags
+		}
+
+		priorElems := priorMap.Elements()
+		plannedElems := plannedMap.Elements()
+		keys := make([]string, 0, len(priorElems)+len(plannedElems))
+
+		for key := range priorElems {
+			keys = append(keys, key)
+		}
+
+		for key := range plannedElems {
+			if _, ok := priorElems[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			priorElem, priorOk := priorElems[key]
+			plannedElem, plannedOk := plannedElems[key]
+			entry := ChangeSetEntry{
+				Path: path.Empty().AtMapKey(key),
+			}
+
+			switch {
+			case !priorOk:
+				entry.Planned = plannedElem
+				changeSet.Additions = append(changeSet.Additions, entry)
+			case !plannedOk:
+				entry.Prior = priorElem
+				changeSet.Removals = append(changeSet.Removals, entry)
+			case !priorElem.Equal(plannedElem):
+				entry.Prior = priorElem
+				entry.Planned = plannedElem
+				changeSet.Modifications = append(changeSet.Modifications, entry)
+			}
+		}
+	default:
+		diags.AddError(
+			"Collection Change Set Error",
+			"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Only List, Set, and Map values are supported, got: %s", prior.Type(ctx)),
+		)
+	}
+
+	return changeSet, diags
+}
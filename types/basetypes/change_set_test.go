@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCollectionChangeSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior         attr.Value
+		planned       attr.Value
+		expected      ChangeSet
+		expectedDiags diag.Diagnostics
+	}{
+		"list": {
+			prior: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+			planned: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("x"),
+				},
+			),
+			expected: ChangeSet{
+				Removals: []ChangeSetEntry{
+					{
+						Path:  path.Empty().AtListIndex(2),
+						Prior: NewStringValue("c"),
+					},
+				},
+				Modifications: []ChangeSetEntry{
+					{
+						Path:    path.Empty().AtListIndex(1),
+						Prior:   NewStringValue("b"),
+						Planned: NewStringValue("x"),
+					},
+				},
+			},
+		},
+		"list-null-prior": {
+			prior: NewListNull(StringType{}),
+			planned: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			expected: ChangeSet{
+				Additions: []ChangeSetEntry{
+					{
+						Path:    path.Empty().AtListIndex(0),
+						Planned: NewStringValue("a"),
+					},
+				},
+			},
+		},
+		"set": {
+			prior: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			planned: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("c"),
+					NewStringValue("a"),
+				},
+			),
+			expected: ChangeSet{
+				Additions: []ChangeSetEntry{
+					{
+						Path:    path.Empty().AtSetValue(NewStringValue("c")),
+						Planned: NewStringValue("c"),
+					},
+				},
+				Removals: []ChangeSetEntry{
+					{
+						Path:  path.Empty().AtSetValue(NewStringValue("b")),
+						Prior: NewStringValue("b"),
+					},
+				},
+			},
+		},
+		"map": {
+			prior: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"keep":    NewStringValue("same"),
+					"modify":  NewStringValue("before"),
+					"removed": NewStringValue("gone"),
+				},
+			),
+			planned: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"added":  NewStringValue("new"),
+					"keep":   NewStringValue("same"),
+					"modify": NewStringValue("after"),
+				},
+			),
+			expected: ChangeSet{
+				Additions: []ChangeSetEntry{
+					{
+						Path:    path.Empty().AtMapKey("added"),
+						Planned: NewStringValue("new"),
+					},
+				},
+				Removals: []ChangeSetEntry{
+					{
+						Path:  path.Empty().AtMapKey("removed"),
+						Prior: NewStringValue("gone"),
+					},
+				},
+				Modifications: []ChangeSetEntry{
+					{
+						Path:    path.Empty().AtMapKey("modify"),
+						Prior:   NewStringValue("before"),
+						Planned: NewStringValue("after"),
+					},
+				},
+			},
+		},
+		"equal": {
+			prior:    NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("a")}),
+			planned:  NewMapValueMust(StringType{}, map[string]attr.Value{"a": NewStringValue("a")}),
+			expected: ChangeSet{},
+		},
+		"unknown": {
+			prior:   NewListUnknown(StringType{}),
+			planned: NewListValueMust(StringType{}, []attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Collection Change Set Error",
+					"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unknown values cannot be compared. Prior: <unknown>, Planned: []",
+				),
+			},
+		},
+		"mismatched-types": {
+			prior:   NewListValueMust(StringType{}, []attr.Value{}),
+			planned: NewSetValueMust(StringType{}, []attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Collection Change Set Error",
+					"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Prior type types.ListType[basetypes.StringType] does not match planned type types.SetType[basetypes.StringType].",
+				),
+			},
+		},
+		"unsupported-type": {
+			prior:   NewStringValue("a"),
+			planned: NewStringValue("b"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Collection Change Set Error",
+					"An unexpected error was encountered trying to compute a collection change set. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Only List, Set, and Map values are supported, got: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := CollectionChangeSet(testCase.prior, testCase.planned)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+var (
+	_ CollectionValue = ListValue{}
+	_ CollectionValue = MapValue{}
+	_ CollectionValue = SetValue{}
+)
+
+// CollectionValue is an attr.Value containing elements of a single type,
+// implemented by ListValue, MapValue, and SetValue. It enables logic which
+// iterates over the elements of any collection without a type switch.
+type CollectionValue interface {
+	attr.Value
+
+	// ElementType should return the type of the collection elements.
+	ElementType(context.Context) attr.Type
+
+	// ElementValues should return a copy of the collection element values.
+	// Null and unknown collections should return an empty slice.
+	ElementValues() []attr.Value
+
+	// Len should return the number of collection elements. Null and unknown
+	// collections should return zero.
+	Len() int
+}
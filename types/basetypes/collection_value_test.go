@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestCollectionValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input                 CollectionValue
+		expectedElementType   attr.Type
+		expectedElementValues []attr.Value
+		expectedLen           int
+	}{
+		"list-null": {
+			input:                 NewListNull(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"list-unknown": {
+			input:                 NewListUnknown(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"list-value": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("a"),
+				},
+			),
+			expectedElementType: StringType{},
+			expectedElementValues: []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			},
+			expectedLen: 2,
+		},
+		"map-null": {
+			input:                 NewMapNull(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"map-unknown": {
+			input:                 NewMapUnknown(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"map-value": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key2": NewStringValue("b"),
+					"key1": NewStringValue("a"),
+					"key3": NewStringNull(),
+				},
+			),
+			expectedElementType: StringType{},
+			expectedElementValues: []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringNull(),
+			},
+			expectedLen: 3,
+		},
+		"set-null": {
+			input:                 NewSetNull(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"set-unknown": {
+			input:                 NewSetUnknown(StringType{}),
+			expectedElementType:   StringType{},
+			expectedElementValues: []attr.Value{},
+			expectedLen:           0,
+		},
+		"set-value": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			expectedElementType: StringType{},
+			expectedElementValues: []attr.Value{
+				NewStringValue("a"),
+			},
+			expectedLen: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if diff := cmp.Diff(testCase.input.ElementType(ctx), testCase.expectedElementType); diff != "" {
+				t.Errorf("unexpected element type difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.input.ElementValues(), testCase.expectedElementValues); diff != "" {
+				t.Errorf("unexpected element values difference: %s", diff)
+			}
+
+			if got := testCase.input.Len(); got != testCase.expectedLen {
+				t.Errorf("expected length %d, got: %d", testCase.expectedLen, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ attr.TypeWithValueCopy = DynamicType{}
+	_ attr.TypeWithValueCopy = ListType{}
+	_ attr.TypeWithValueCopy = MapType{}
+	_ attr.TypeWithValueCopy = ObjectType{}
+	_ attr.TypeWithValueCopy = OrderedMapType{}
+	_ attr.TypeWithValueCopy = SetType{}
+	_ attr.TypeWithValueCopy = TupleType{}
+)
+
+// copySliceValues returns a deep copy of the given values with attr.Copy, in
+// a fresh slice.
+func copySliceValues(ctx context.Context, values []attr.Value) ([]attr.Value, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	result := make([]attr.Value, 0, len(values))
+
+	for _, value := range values {
+		valueCopy, err := attr.Copy(ctx, value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, valueCopy)
+	}
+
+	return result, nil
+}
+
+// copyMapValues returns a deep copy of the given values with attr.Copy, in a
+// fresh map.
+func copyMapValues(ctx context.Context, values map[string]attr.Value) (map[string]attr.Value, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	result := make(map[string]attr.Value, len(values))
+
+	for key, value := range values {
+		valueCopy, err := attr.Copy(ctx, value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result[key] = valueCopy
+	}
+
+	return result, nil
+}
+
+// copyPaths returns a copy of the given paths, such as state redacted paths,
+// in a fresh slice.
+func copyPaths(paths []path.Path) []path.Path {
+	if paths == nil {
+		return nil
+	}
+
+	result := make([]path.Path, 0, len(paths))
+
+	for _, p := range paths {
+		result = append(result, p.Copy())
+	}
+
+	return result
+}
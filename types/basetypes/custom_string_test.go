@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ StringTypable  = customStringType{}
+	_ StringValuable = customStringValue{}
+
+	_ StringTypable                    = caseInsensitiveStringType{}
+	_ StringValuableWithSemanticEquals = caseInsensitiveStringValue{}
+)
+
+// customStringType is a custom string type for testing behaviors with types
+// which are not the base framework types.
+type customStringType struct {
+	StringType
+}
+
+func (t customStringType) Equal(o attr.Type) bool {
+	_, ok := o.(customStringType)
+
+	return ok
+}
+
+func (t customStringType) String() string {
+	return "basetypes.customStringType"
+}
+
+func (t customStringType) ValueFromString(_ context.Context, v StringValue) (StringValuable, diag.Diagnostics) {
+	return customStringValue{StringValue: v}, nil
+}
+
+func (t customStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := v.(StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	return customStringValue{StringValue: stringValue}, nil
+}
+
+func (t customStringType) ValueType(_ context.Context) attr.Value {
+	return customStringValue{}
+}
+
+// wireStringType is a custom string type whose ValueFromTerraform creates
+// base StringValue, for testing behaviors with types which are only
+// compatible with their values in the Terraform type system. Its
+// ValueFromString method creates wireStringValue.
+type wireStringType struct {
+	StringType
+}
+
+func (t wireStringType) Equal(o attr.Type) bool {
+	_, ok := o.(wireStringType)
+
+	return ok
+}
+
+func (t wireStringType) String() string {
+	return "basetypes.wireStringType"
+}
+
+func (t wireStringType) ValueFromString(_ context.Context, in StringValue) (StringValuable, diag.Diagnostics) {
+	return wireStringValue{StringValue: in}, nil
+}
+
+// wireStringValue is the value type for wireStringType.
+type wireStringValue struct {
+	StringValue
+}
+
+func (v wireStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(wireStringValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v wireStringValue) Type(_ context.Context) attr.Type {
+	return wireStringType{}
+}
+
+// wireOnlyStringType is a custom string type which only creates base
+// StringValue, for testing behaviors with types whose values cannot be
+// converted to the type.
+type wireOnlyStringType struct {
+	StringType
+}
+
+func (t wireOnlyStringType) Equal(o attr.Type) bool {
+	_, ok := o.(wireOnlyStringType)
+
+	return ok
+}
+
+func (t wireOnlyStringType) String() string {
+	return "basetypes.wireOnlyStringType"
+}
+
+// customStringValue is the value type for customStringType.
+type customStringValue struct {
+	StringValue
+}
+
+func (v customStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(customStringValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v customStringValue) Type(_ context.Context) attr.Type {
+	return customStringType{}
+}
+
+// caseInsensitiveStringType is a custom string type whose values are
+// semantically equal regardless of letter case.
+type caseInsensitiveStringType struct {
+	StringType
+}
+
+func (t caseInsensitiveStringType) Equal(o attr.Type) bool {
+	_, ok := o.(caseInsensitiveStringType)
+
+	return ok
+}
+
+func (t caseInsensitiveStringType) String() string {
+	return "basetypes.caseInsensitiveStringType"
+}
+
+func (t caseInsensitiveStringType) ValueFromString(_ context.Context, v StringValue) (StringValuable, diag.Diagnostics) {
+	return caseInsensitiveStringValue{StringValue: v}, nil
+}
+
+func (t caseInsensitiveStringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := v.(StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	return caseInsensitiveStringValue{StringValue: stringValue}, nil
+}
+
+func (t caseInsensitiveStringType) ValueType(_ context.Context) attr.Value {
+	return caseInsensitiveStringValue{}
+}
+
+// caseInsensitiveStringValue is the value type for caseInsensitiveStringType.
+type caseInsensitiveStringValue struct {
+	StringValue
+}
+
+func (v caseInsensitiveStringValue) Equal(o attr.Value) bool {
+	other, ok := o.(caseInsensitiveStringValue)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v caseInsensitiveStringValue) StringSemanticEquals(_ context.Context, o StringValuable) (bool, diag.Diagnostics) {
+	other, ok := o.(caseInsensitiveStringValue)
+
+	if !ok {
+		return false, nil
+	}
+
+	return strings.EqualFold(v.ValueString(), other.ValueString()), nil
+}
+
+func (v caseInsensitiveStringValue) Type(_ context.Context) attr.Type {
+	return caseInsensitiveStringType{}
+}
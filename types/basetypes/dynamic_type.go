@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// DynamicTypable extends attr.Type for dynamic types.
+// Implement this interface to create a custom DynamicType type.
+type DynamicTypable interface {
+	attr.Type
+
+	// ValueFromDynamic should convert the Dynamic to a DynamicValuable type.
+	ValueFromDynamic(context.Context, DynamicValue) (DynamicValuable, diag.Diagnostics)
+}
+
+var _ DynamicTypable = DynamicType{}
+
+// DynamicType is the base framework type for a value whose concrete type is
+// not determined until runtime, such as arbitrary JSON-like configuration.
+// DynamicValue is the associated value type, which contains an underlying
+// value of a concrete base type.
+type DynamicType struct{}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// type. The concrete type of a dynamic value is not known, so all steps
+// return an error.
+func (t DynamicType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	return nil, fmt.Errorf("cannot apply AttributePathStep %T to %s", step, t.String())
+}
+
+// Equal returns true if the given type is equivalent.
+func (t DynamicType) Equal(o attr.Type) bool {
+	_, ok := o.(DynamicType)
+
+	return ok
+}
+
+// String returns a human readable string of the type name.
+func (t DynamicType) String() string {
+	return "basetypes.DynamicType"
+}
+
+// TerraformType returns the tftypes.Type that should be used to represent this
+// framework type.
+func (t DynamicType) TerraformType(_ context.Context) tftypes.Type {
+	return tftypes.DynamicPseudoType
+}
+
+// ValueFromDynamic returns a DynamicValuable type given a DynamicValue.
+func (t DynamicType) ValueFromDynamic(_ context.Context, v DynamicValue) (DynamicValuable, diag.Diagnostics) {
+	return v, nil
+}
+
+// CopyValue returns a deep copy of the given known Dynamic value, with a deep
+// copy of the underlying value created with attr.Copy. The copy is converted
+// with the ValueFromDynamic method of the value type, so custom types are
+// preserved.
+func (t DynamicType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(DynamicValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	dynamic, diags := valuable.ToDynamicValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to DynamicValue: %v", diags)
+	}
+
+	underlyingValue, err := attr.Copy(ctx, dynamic.value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dynamic.value = underlyingValue
+
+	typable, ok := v.Type(ctx).(DynamicTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromDynamic(ctx, dynamic)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting DynamicValue to DynamicValuable: %v", diags)
+	}
+
+	return result, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value. This is meant to
+// convert the tftypes.Value into a more convenient Go type for the provider to
+// consume the data with.
+//
+// Values with a concrete Terraform type are converted into the associated
+// base type value, such as a StringValue for tftypes.String, which is
+// available via the DynamicValue UnderlyingValue method. Null and unknown
+// values without a concrete Terraform type return a null or unknown
+// DynamicValue.
+func (t DynamicType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewDynamicNull(), nil
+	}
+
+	if in.Type().Is(tftypes.DynamicPseudoType) {
+		if !in.IsKnown() {
+			return NewDynamicUnknown(), nil
+		}
+
+		if in.IsNull() {
+			return NewDynamicNull(), nil
+		}
+
+		return nil, fmt.Errorf("unexpected known value without a concrete type: %s", in)
+	}
+
+	underlyingType, err := dynamicUnderlyingType(in.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	underlyingValue, err := underlyingType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDynamicValue(underlyingValue), nil
+}
+
+// ValueType returns the Value type.
+func (t DynamicType) ValueType(_ context.Context) attr.Value {
+	return DynamicValue{}
+}
+
+// dynamicUnderlyingType returns the base type associated with the given
+// concrete Terraform type.
+func dynamicUnderlyingType(in tftypes.Type) (attr.Type, error) {
+	switch {
+	case in.Is(tftypes.DynamicPseudoType):
+		return DynamicType{}, nil
+	case in.Is(tftypes.String):
+		return StringType{}, nil
+	case in.Is(tftypes.Number):
+		return NumberType{}, nil
+	case in.Is(tftypes.Bool):
+		return BoolType{}, nil
+	}
+
+	switch typ := in.(type) {
+	case tftypes.List:
+		elemType, err := dynamicUnderlyingType(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return ListType{ElemType: elemType}, nil
+	case tftypes.Set:
+		elemType, err := dynamicUnderlyingType(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return SetType{ElemType: elemType}, nil
+	case tftypes.Map:
+		elemType, err := dynamicUnderlyingType(typ.ElementType)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return MapType{ElemType: elemType}, nil
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type, len(typ.AttributeTypes))
+
+		for name, attrType := range typ.AttributeTypes {
+			underlyingType, err := dynamicUnderlyingType(attrType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			attrTypes[name] = underlyingType
+		}
+
+		return ObjectType{AttrTypes: attrTypes}, nil
+	case tftypes.Tuple:
+		elemTypes := make([]attr.Type, 0, len(typ.ElementTypes))
+
+		for _, elemType := range typ.ElementTypes {
+			underlyingType, err := dynamicUnderlyingType(elemType)
+
+			if err != nil {
+				return nil, err
+			}
+
+			elemTypes = append(elemTypes, underlyingType)
+		}
+
+		return TupleType{ElemTypes: elemTypes}, nil
+	default:
+		return nil, fmt.Errorf("unsupported dynamic value type: %s", in)
+	}
+}
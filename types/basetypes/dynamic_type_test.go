@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDynamicTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	got := DynamicType{}.TerraformType(context.Background())
+
+	if !got.Equal(tftypes.DynamicPseudoType) {
+		t.Errorf("expected %s, got %s", tftypes.DynamicPseudoType, got)
+	}
+}
+
+func TestDynamicTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"nil-type": {
+			input:    tftypes.NewValue(nil, nil),
+			expected: NewDynamicNull(),
+		},
+		"dynamic-null": {
+			input:    tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+			expected: NewDynamicNull(),
+		},
+		"dynamic-unknown": {
+			input:    tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expected: NewDynamicUnknown(),
+		},
+		"string": {
+			input:    tftypes.NewValue(tftypes.String, "hello"),
+			expected: NewDynamicValue(NewStringValue("hello")),
+		},
+		"string-null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: NewDynamicValue(NewStringNull()),
+		},
+		"list": {
+			input: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.Bool},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+			expected: NewDynamicValue(
+				NewListValueMust(
+					BoolType{},
+					[]attr.Value{
+						NewBoolValue(true),
+					},
+				),
+			),
+		},
+		"object": {
+			input: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+						"tags": tftypes.Map{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "example"),
+					"tags": tftypes.NewValue(
+						tftypes.Map{ElementType: tftypes.String},
+						map[string]tftypes.Value{
+							"env": tftypes.NewValue(tftypes.String, "test"),
+						},
+					),
+				},
+			),
+			expected: NewDynamicValue(
+				NewObjectValueMust(
+					map[string]attr.Type{
+						"name": StringType{},
+						"tags": MapType{ElemType: StringType{}},
+					},
+					map[string]attr.Value{
+						"name": NewStringValue("example"),
+						"tags": NewMapValueMust(
+							StringType{},
+							map[string]attr.Value{
+								"env": NewStringValue("test"),
+							},
+						),
+					},
+				),
+			),
+		},
+		"tuple": {
+			input: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expected: NewDynamicValue(
+				NewTupleValueMust(
+					[]attr.Type{StringType{}},
+					[]attr.Value{
+						NewStringValue("hello"),
+					},
+				),
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := DynamicType{}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+
+				return
+			}
+
+			if testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got nil", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    attr.Type
+		expected bool
+	}{
+		"dynamic": {
+			input:    DynamicType{},
+			expected: true,
+		},
+		"string": {
+			input:    StringType{},
+			expected: false,
+		},
+		"nil": {
+			input:    nil,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := DynamicType{}.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
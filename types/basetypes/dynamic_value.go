@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var (
+	_ DynamicValuable = DynamicValue{}
+)
+
+// DynamicValuable extends attr.Value for dynamic value types.
+// Implement this interface to create a custom Dynamic value type.
+type DynamicValuable interface {
+	attr.Value
+
+	// ToDynamicValue should convert the value type to a Dynamic.
+	ToDynamicValue(ctx context.Context) (DynamicValue, diag.Diagnostics)
+}
+
+// NewDynamicNull creates a Dynamic with a null value and no underlying
+// value. Determine whether the value is null via the Dynamic type IsNull
+// method.
+func NewDynamicNull() DynamicValue {
+	return DynamicValue{
+		state: attr.ValueStateNull,
+	}
+}
+
+// NewDynamicUnknown creates a Dynamic with an unknown value and no
+// underlying value. Determine whether the value is unknown via the Dynamic
+// type IsUnknown method.
+func NewDynamicUnknown() DynamicValue {
+	return DynamicValue{
+		state: attr.ValueStateUnknown,
+	}
+}
+
+// NewDynamicValue creates a Dynamic with a known value, containing the given
+// underlying value of a concrete type. Access the underlying value via the
+// Dynamic type UnderlyingValue method. A nil underlying value creates a null
+// Dynamic.
+func NewDynamicValue(value attr.Value) DynamicValue {
+	if value == nil {
+		return NewDynamicNull()
+	}
+
+	return DynamicValue{
+		state: attr.ValueStateKnown,
+		value: value,
+	}
+}
+
+// DynamicValue represents a value whose concrete type is determined at
+// runtime. Known values contain an underlying value of a concrete type, which
+// may itself be null or unknown.
+type DynamicValue struct {
+	// state represents whether the value is null, unknown, or known. The
+	// zero-value is null.
+	state attr.ValueState
+
+	// value contains the underlying value, if not null or unknown.
+	value attr.Value
+}
+
+// Type returns a DynamicType.
+func (v DynamicValue) Type(_ context.Context) attr.Type {
+	return DynamicType{}
+}
+
+// ToTerraformValue returns the data contained in the Dynamic as a
+// tftypes.Value. Known values return the Terraform value of the underlying
+// value, while null and unknown values use tftypes.DynamicPseudoType.
+func (v DynamicValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	switch v.state {
+	case attr.ValueStateKnown:
+		return v.value.ToTerraformValue(ctx)
+	case attr.ValueStateNull:
+		return tftypes.NewValue(tftypes.DynamicPseudoType, nil), nil
+	case attr.ValueStateUnknown:
+		return tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue), nil
+	default:
+		panic(fmt.Sprintf("unhandled Dynamic state in ToTerraformValue: %s", v.state))
+	}
+}
+
+// Equal returns true if `other` is also a Dynamic, has the same value state,
+// and has an underlying value which is equal as defined by the Equal method
+// of the underlying value. A Dynamic is never equal to a value of its
+// underlying type.
+func (v DynamicValue) Equal(other attr.Value) bool {
+	o, ok := other.(DynamicValue)
+
+	if !ok {
+		return false
+	}
+
+	if v.state != o.state {
+		return false
+	}
+
+	if v.state != attr.ValueStateKnown {
+		return true
+	}
+
+	return v.value.Equal(o.value)
+}
+
+// IsNull returns true if the Dynamic represents a null value. A known
+// Dynamic with a null underlying value is not null, use the
+// IsUnderlyingValueNull method to check the underlying value.
+func (v DynamicValue) IsNull() bool {
+	return v.state == attr.ValueStateNull
+}
+
+// IsUnknown returns true if the Dynamic represents a currently unknown value.
+// A known Dynamic with an unknown underlying value is not unknown, use the
+// IsUnderlyingValueUnknown method to check the underlying value.
+func (v DynamicValue) IsUnknown() bool {
+	return v.state == attr.ValueStateUnknown
+}
+
+// IsUnderlyingValueNull returns true if the Dynamic is known and contains a
+// null underlying value.
+func (v DynamicValue) IsUnderlyingValueNull() bool {
+	return v.state == attr.ValueStateKnown && v.value.IsNull()
+}
+
+// IsUnderlyingValueUnknown returns true if the Dynamic is known and contains
+// an unknown underlying value.
+func (v DynamicValue) IsUnderlyingValueUnknown() bool {
+	return v.state == attr.ValueStateKnown && v.value.IsUnknown()
+}
+
+// String returns a human-readable representation of the Dynamic value. Known
+// values return the String of the underlying value.
+//
+// The string returned here is not protected by any compatibility guarantees,
+// and is intended for logging and error reporting.
+func (v DynamicValue) String() string {
+	if v.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if v.IsNull() {
+		return attr.NullValueString
+	}
+
+	return v.value.String()
+}
+
+// UnderlyingValue returns the underlying value of a known Dynamic. If
+// Dynamic is null or unknown, returns nil.
+func (v DynamicValue) UnderlyingValue() attr.Value {
+	return v.value
+}
+
+// ToDynamicValue returns Dynamic.
+func (v DynamicValue) ToDynamicValue(context.Context) (DynamicValue, diag.Diagnostics) {
+	return v, nil
+}
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDynamicValueToTerraformValue_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input      DynamicValue
+		expectedTf tftypes.Value
+	}{
+		"null": {
+			input:      NewDynamicNull(),
+			expectedTf: tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		},
+		"unknown": {
+			input:      NewDynamicUnknown(),
+			expectedTf: tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+		},
+		"string": {
+			input:      NewDynamicValue(NewStringValue("hello")),
+			expectedTf: tftypes.NewValue(tftypes.String, "hello"),
+		},
+		"list": {
+			input: NewDynamicValue(
+				NewListValueMust(
+					StringType{},
+					[]attr.Value{
+						NewStringValue("hello"),
+						NewStringNull(),
+					},
+				),
+			),
+			expectedTf: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		"object": {
+			input: NewDynamicValue(
+				NewObjectValueMust(
+					map[string]attr.Type{
+						"enabled": BoolType{},
+						"names":   ListType{ElemType: StringType{}},
+					},
+					map[string]attr.Value{
+						"enabled": NewBoolValue(true),
+						"names": NewListValueMust(
+							StringType{},
+							[]attr.Value{
+								NewStringValue("example"),
+							},
+						),
+					},
+				),
+			),
+			expectedTf: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"enabled": tftypes.Bool,
+						"names":   tftypes.List{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"names": tftypes.NewValue(
+						tftypes.List{ElementType: tftypes.String},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "example"),
+						},
+					),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			gotTf, err := testCase.input.ToTerraformValue(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(gotTf, testCase.expectedTf); diff != "" {
+				t.Errorf("unexpected ToTerraformValue difference: %s", diff)
+			}
+
+			got, err := DynamicType{}.ValueFromTerraform(ctx, gotTf)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.input) {
+				t.Errorf("expected round trip value %s, got %s", testCase.input, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver DynamicValue
+		input    attr.Value
+		expected bool
+	}{
+		"known-known-same": {
+			receiver: NewDynamicValue(NewStringValue("hello")),
+			input:    NewDynamicValue(NewStringValue("hello")),
+			expected: true,
+		},
+		"known-known-diff-value": {
+			receiver: NewDynamicValue(NewStringValue("hello")),
+			input:    NewDynamicValue(NewStringValue("world")),
+			expected: false,
+		},
+		"known-known-diff-underlying-type": {
+			receiver: NewDynamicValue(NewStringValue("true")),
+			input:    NewDynamicValue(NewBoolValue(true)),
+			expected: false,
+		},
+		"known-underlying-value": {
+			receiver: NewDynamicValue(NewStringValue("hello")),
+			input:    NewStringValue("hello"),
+			expected: false,
+		},
+		"known-null": {
+			receiver: NewDynamicValue(NewStringNull()),
+			input:    NewDynamicNull(),
+			expected: false,
+		},
+		"null-null": {
+			receiver: NewDynamicNull(),
+			input:    NewDynamicNull(),
+			expected: true,
+		},
+		"unknown-unknown": {
+			receiver: NewDynamicUnknown(),
+			input:    NewDynamicUnknown(),
+			expected: true,
+		},
+		"unknown-null": {
+			receiver: NewDynamicUnknown(),
+			input:    NewDynamicNull(),
+			expected: false,
+		},
+		"nil": {
+			receiver: NewDynamicNull(),
+			input:    nil,
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueIsUnderlyingValueNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input                  DynamicValue
+		expectedNull           bool
+		expectedUnderlyingNull bool
+	}{
+		"null": {
+			input:        NewDynamicNull(),
+			expectedNull: true,
+		},
+		"known-null-underlying": {
+			input:                  NewDynamicValue(NewStringNull()),
+			expectedUnderlyingNull: true,
+		},
+		"known": {
+			input: NewDynamicValue(NewStringValue("hello")),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.input.IsNull(); got != testCase.expectedNull {
+				t.Errorf("expected IsNull %t, got %t", testCase.expectedNull, got)
+			}
+
+			if got := testCase.input.IsUnderlyingValueNull(); got != testCase.expectedUnderlyingNull {
+				t.Errorf("expected IsUnderlyingValueNull %t, got %t", testCase.expectedUnderlyingNull, got)
+			}
+		})
+	}
+}
+
+func TestDynamicValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    DynamicValue
+		expected string
+	}{
+		"known": {
+			input:    NewDynamicValue(NewStringValue("hello")),
+			expected: `"hello"`,
+		},
+		"null": {
+			input:    NewDynamicNull(),
+			expected: "<null>",
+		},
+		"unknown": {
+			input:    NewDynamicUnknown(),
+			expected: "<unknown>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.String()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
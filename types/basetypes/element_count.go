@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// elementCountDiagnostic returns an error diagnostic if `count` is less than
+// `minItems` or greater than `maxItems`, otherwise nil. A `minItems` or
+// `maxItems` of zero or less is not checked. Both the MinItems and MaxItems validation of collection
+// types and the ElementsAsBounded methods of collection values use it, so
+// their diagnostics are consistent.
+func elementCountDiagnostic(count int, minItems int, maxItems int) diag.Diagnostic {
+	hasMin := minItems > 0
+	hasMax := maxItems > 0
+
+	if (!hasMin || count >= minItems) && (!hasMax || count <= maxItems) {
+		return nil
+	}
+
+	var detail string
+
+	switch {
+	case hasMin && hasMax:
+		detail = fmt.Sprintf("Expected between %d and %d elements, got: %d.", minItems, maxItems, count)
+	case hasMin:
+		detail = fmt.Sprintf("Expected at least %d elements, got: %d.", minItems, count)
+	default:
+		detail = fmt.Sprintf("Expected at most %d elements, got: %d.", maxItems, count)
+	}
+
+	return diag.NewErrorDiagnostic("Invalid Element Count", detail)
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// limitElementDiagnostics returns the first `max` element diagnostics, with a
+// summary diagnostic at the collection path describing how many diagnostics
+// were omitted. The summary is an error if any omitted diagnostic is an
+// error, otherwise a warning. Zero or negative `max` values return all
+// diagnostics.
+func limitElementDiagnostics(collectionPath path.Path, diags diag.Diagnostics, max int) diag.Diagnostics {
+	if max <= 0 || len(diags) <= max {
+		return diags
+	}
+
+	omitted := diags[max:]
+	result := make(diag.Diagnostics, 0, max+1)
+	result = append(result, diags[:max]...)
+
+	summary := "Too Many Element Diagnostics"
+	detail := fmt.Sprintf("Element diagnostics were limited to %d, and %d more were omitted.", max, len(omitted))
+
+	if omitted.HasError() {
+		result.AddAttributeError(collectionPath, summary, detail)
+	} else {
+		result.AddAttributeWarning(collectionPath, summary, detail)
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// validateElements calls `validate` for each element index below `count`,
+// returning the diagnostics of each call at the same index. When
+// `concurrency` is greater than one, the calls are made concurrently with at
+// most that many goroutines. When `concurrency` is negative, at most
+// runtime.GOMAXPROCS goroutines are used. Otherwise the calls are made
+// sequentially.
+// Callers should combine the results in index order, so the diagnostics are
+// ordered consistently regardless of concurrency.
+func validateElements(concurrency int, count int, validate func(index int) diag.Diagnostics) []diag.Diagnostics {
+	results := make([]diag.Diagnostics, count)
+
+	if concurrency < 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	if concurrency <= 1 {
+		for index := 0; index < count; index++ {
+			results[index] = validate(index)
+		}
+
+		return results
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < concurrency && worker < count; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for index := range indices {
+				results[index] = validate(index)
+			}
+		}()
+	}
+
+	for index := 0; index < count; index++ {
+		indices <- index
+	}
+
+	close(indices)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ xattr.TypeWithValidate = slowValidateStringType{}
+
+// slowValidateStringType is an element type which returns a warning for each
+// validated value. Values are expected to be integer strings, with smaller
+// values taking longer to validate, so concurrent validation finishes out of
+// element order.
+type slowValidateStringType struct {
+	StringType
+}
+
+func (t slowValidateStringType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(path, "Unexpected Error", err.Error())
+
+		return diags
+	}
+
+	n, err := strconv.Atoi(s)
+
+	if err != nil {
+		diags.AddAttributeError(path, "Unexpected Error", err.Error())
+
+		return diags
+	}
+
+	time.Sleep(time.Duration(10-n) * time.Millisecond)
+
+	diags.AddAttributeWarning(path, "Validated", s)
+
+	return diags
+}
+
+func TestValidateElements_ordering(t *testing.T) {
+	t.Parallel()
+
+	elements := make([]tftypes.Value, 0, 10)
+	mapElements := make(map[string]tftypes.Value, 10)
+	expectedListDiags := make(diag.Diagnostics, 0, 10)
+	expectedMapDiags := make(diag.Diagnostics, 0, 10)
+	expectedSetDiags := make(diag.Diagnostics, 0, 10)
+
+	for idx := 0; idx < 10; idx++ {
+		value := strconv.Itoa(idx)
+		key := fmt.Sprintf("key%d", idx)
+
+		elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		mapElements[key] = tftypes.NewValue(tftypes.String, value)
+
+		expectedListDiags = append(expectedListDiags, diag.NewAttributeWarningDiagnostic(path.Root("test").AtListIndex(idx), "Validated", value))
+		expectedMapDiags = append(expectedMapDiags, diag.NewAttributeWarningDiagnostic(path.Root("test").AtMapKey(key), "Validated", value))
+		expectedSetDiags = append(expectedSetDiags, diag.NewAttributeWarningDiagnostic(path.Root("test").AtSetValue(NewStringValue(value)), "Validated", value))
+	}
+
+	listValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	mapValue := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, mapElements)
+	setValue := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+
+	testCases := map[string]struct {
+		validate      func(concurrency int) diag.Diagnostics
+		expectedDiags diag.Diagnostics
+	}{
+		"list": {
+			validate: func(concurrency int) diag.Diagnostics {
+				listType := ListType{
+					ElemType:                     slowValidateStringType{},
+					ElementValidationConcurrency: concurrency,
+				}
+
+				return listType.Validate(context.Background(), listValue, path.Root("test"))
+			},
+			expectedDiags: expectedListDiags,
+		},
+		"map": {
+			validate: func(concurrency int) diag.Diagnostics {
+				mapType := MapType{
+					ElemType:                     slowValidateStringType{},
+					ElementValidationConcurrency: concurrency,
+				}
+
+				return mapType.Validate(context.Background(), mapValue, path.Root("test"))
+			},
+			expectedDiags: expectedMapDiags,
+		},
+		"set": {
+			validate: func(concurrency int) diag.Diagnostics {
+				setType := SetType{
+					ElemType:                     slowValidateStringType{},
+					ElementValidationConcurrency: concurrency,
+				}
+
+				return setType.Validate(context.Background(), setValue, path.Root("test"))
+			},
+			expectedDiags: expectedSetDiags,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, concurrency := range []int{-1, 0, 1, 4, 20} {
+				diags := testCase.validate(concurrency)
+
+				if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+					t.Errorf("unexpected diagnostics difference with concurrency %d: %s", concurrency, diff)
+				}
+			}
+		})
+	}
+}
+
+var _ xattr.TypeWithValidate = hashValidateStringType{}
+
+// hashValidateStringType is an element type with CPU-bound validation, which
+// returns a warning for each validated value whose hash is even.
+type hashValidateStringType struct {
+	StringType
+}
+
+func (t hashValidateStringType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(path, "Unexpected Error", err.Error())
+
+		return diags
+	}
+
+	sum := sha256.Sum256([]byte(s))
+
+	for i := 0; i < 100; i++ {
+		sum = sha256.Sum256(sum[:])
+	}
+
+	if sum[0]%2 == 0 {
+		diags.AddAttributeWarning(path, "Validated", s)
+	}
+
+	return diags
+}
+
+func TestValidateElements_orderingGOMAXPROCS(t *testing.T) {
+	t.Parallel()
+
+	elements := make([]tftypes.Value, 0, 1000)
+
+	for idx := 0; idx < 1000; idx++ {
+		elements = append(elements, tftypes.NewValue(tftypes.String, strconv.Itoa(idx)))
+	}
+
+	in := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+
+	expectedDiags := ListType{
+		ElemType: hashValidateStringType{},
+	}.Validate(context.Background(), in, path.Root("test"))
+
+	if len(expectedDiags) == 0 {
+		t.Fatal("expected diagnostics from sequential validation, got none")
+	}
+
+	for run := 0; run < 5; run++ {
+		diags := ListType{
+			ElemType:                     hashValidateStringType{},
+			ElementValidationConcurrency: -1,
+		}.Validate(context.Background(), in, path.Root("test"))
+
+		if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+			t.Fatalf("unexpected diagnostics difference on run %d: %s", run, diff)
+		}
+	}
+}
+
+func benchmarkListTypeValidateConcurrency(b *testing.B, elementCount int, concurrency int) {
+	elements := make([]tftypes.Value, 0, elementCount)
+
+	for idx := 0; idx < elementCount; idx++ {
+		elements = append(elements, tftypes.NewValue(tftypes.String, strconv.Itoa(idx)))
+	}
+
+	var diags diag.Diagnostics // Prevent compiler optimization
+	ctx := context.Background()
+	in := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	path := path.Root("test")
+	list := ListType{
+		ElemType:                     hashValidateStringType{},
+		ElementValidationConcurrency: concurrency,
+	}
+
+	for n := 0; n < b.N; n++ {
+		diags = list.Validate(ctx, in, path)
+	}
+
+	benchDiags = diags
+}
+
+func BenchmarkListTypeValidate10000_sequential(b *testing.B) {
+	benchmarkListTypeValidateConcurrency(b, 10000, 0)
+}
+
+func BenchmarkListTypeValidate10000_GOMAXPROCS(b *testing.B) {
+	benchmarkListTypeValidateConcurrency(b, 10000, -1)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Fingerprint returns a stable, hex encoded SHA-256 digest of the given fully
+// known value. Equal values always produce equal fingerprints, regardless of
+// set element ordering or map key ordering, which makes the fingerprint
+// suitable for storing in private state to detect changes made outside of
+// Terraform.
+//
+// An error diagnostic is returned if the value, or any nested value, is
+// unknown. The fingerprint format is not protected by compatibility
+// guarantees across framework versions.
+func Fingerprint(ctx context.Context, v attr.Value) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfValue, err := v.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Value Fingerprint Error",
+			"An unexpected error was encountered trying to fingerprint a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	if !tfValue.IsFullyKnown() {
+		diags.AddError(
+			"Value Fingerprint Error",
+			"An unexpected error was encountered trying to fingerprint a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Only fully known values can be fingerprinted, got: "+v.String(),
+		)
+
+		return "", diags
+	}
+
+	encoded, err := fingerprintEncode(tfValue)
+
+	if err != nil {
+		diags.AddError(
+			"Value Fingerprint Error",
+			"An unexpected error was encountered trying to fingerprint a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return "", diags
+	}
+
+	sum := sha256.Sum256([]byte(encoded))
+
+	return hex.EncodeToString(sum[:]), diags
+}
+
+// fingerprintEncode returns a canonical string encoding of a fully known
+// tftypes.Value, where set elements and map keys are sorted.
+func fingerprintEncode(in tftypes.Value) (string, error) {
+	var res strings.Builder
+
+	res.WriteString(in.Type().String())
+
+	if in.IsNull() {
+		res.WriteString("<null>")
+
+		return res.String(), nil
+	}
+
+	res.WriteString("<")
+
+	switch {
+	case in.Type().Is(tftypes.String):
+		var s string
+
+		if err := in.As(&s); err != nil {
+			return "", err
+		}
+
+		res.WriteString(strconv.Quote(s))
+	case in.Type().Is(tftypes.Number):
+		n := big.NewFloat(0)
+
+		if err := in.As(&n); err != nil {
+			return "", err
+		}
+
+		res.WriteString(n.Text('g', -1))
+	case in.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := in.As(&b); err != nil {
+			return "", err
+		}
+
+		res.WriteString(strconv.FormatBool(b))
+	case in.Type().Is(tftypes.List{}), in.Type().Is(tftypes.Set{}), in.Type().Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := in.As(&elems); err != nil {
+			return "", err
+		}
+
+		encodedElems := make([]string, 0, len(elems))
+
+		for _, elem := range elems {
+			encodedElem, err := fingerprintEncode(elem)
+
+			if err != nil {
+				return "", err
+			}
+
+			encodedElems = append(encodedElems, encodedElem)
+		}
+
+		if in.Type().Is(tftypes.Set{}) {
+			sort.Strings(encodedElems)
+		}
+
+		res.WriteString(strings.Join(encodedElems, ","))
+	case in.Type().Is(tftypes.Map{}), in.Type().Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+
+		if err := in.As(&elems); err != nil {
+			return "", err
+		}
+
+		keys := make([]string, 0, len(elems))
+
+		for key := range elems {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for idx, key := range keys {
+			encodedElem, err := fingerprintEncode(elems[key])
+
+			if err != nil {
+				return "", err
+			}
+
+			if idx != 0 {
+				res.WriteString(",")
+			}
+
+			res.WriteString(strconv.Quote(key) + ":" + encodedElem)
+		}
+	default:
+		return "", fmt.Errorf("unsupported value type for fingerprint: %s", in.Type())
+	}
+
+	res.WriteString(">")
+
+	return res.String(), nil
+}
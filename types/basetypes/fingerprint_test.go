@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name": StringType{},
+		"tags": SetType{ElemType: StringType{}},
+	}
+
+	testCases := map[string]struct {
+		a           attr.Value
+		b           attr.Value
+		expectEqual bool
+	}{
+		"equal-strings": {
+			a:           NewStringValue("test"),
+			b:           NewStringValue("test"),
+			expectEqual: true,
+		},
+		"different-strings": {
+			a:           NewStringValue("test"),
+			b:           NewStringValue("other"),
+			expectEqual: false,
+		},
+		"different-types": {
+			a:           NewStringValue("1"),
+			b:           NewInt64Value(1),
+			expectEqual: false,
+		},
+		"null-and-empty": {
+			a:           NewListNull(StringType{}),
+			b:           NewListValueMust(StringType{}, []attr.Value{}),
+			expectEqual: false,
+		},
+		"list-order-sensitive": {
+			a: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b")},
+			),
+			b: NewListValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("a")},
+			),
+			expectEqual: false,
+		},
+		"set-order-insensitive": {
+			a: NewSetValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("a"), NewStringValue("b")},
+			),
+			b: NewSetValueMust(
+				StringType{},
+				[]attr.Value{NewStringValue("b"), NewStringValue("a")},
+			),
+			expectEqual: true,
+		},
+		"map": {
+			a: NewMapValueMust(
+				Int64Type{},
+				map[string]attr.Value{"a": NewInt64Value(1), "b": NewInt64Value(2)},
+			),
+			b: NewMapValueMust(
+				Int64Type{},
+				map[string]attr.Value{"b": NewInt64Value(2), "a": NewInt64Value(1)},
+			),
+			expectEqual: true,
+		},
+		"object-nested-set-order-insensitive": {
+			a: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"name": NewStringValue("test"),
+					"tags": NewSetValueMust(
+						StringType{},
+						[]attr.Value{NewStringValue("a"), NewStringValue("b"), NewStringNull()},
+					),
+				},
+			),
+			b: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"name": NewStringValue("test"),
+					"tags": NewSetValueMust(
+						StringType{},
+						[]attr.Value{NewStringNull(), NewStringValue("b"), NewStringValue("a")},
+					),
+				},
+			),
+			expectEqual: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, diags := Fingerprint(context.Background(), testCase.a)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			b, diags := Fingerprint(context.Background(), testCase.b)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %s", diags)
+			}
+
+			if (a == b) != testCase.expectEqual {
+				t.Errorf("expected equal fingerprints %t, got %q and %q", testCase.expectEqual, a, b)
+			}
+		})
+	}
+}
+
+func TestFingerprint_unknown(t *testing.T) {
+	t.Parallel()
+
+	value := NewListValueMust(
+		StringType{},
+		[]attr.Value{NewStringUnknown()},
+	)
+
+	got, diags := Fingerprint(context.Background(), value)
+
+	if got != "" {
+		t.Errorf("expected empty fingerprint, got %q", got)
+	}
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Value Fingerprint Error",
+			"An unexpected error was encountered trying to fingerprint a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Only fully known values can be fingerprinted, got: [<unknown>]",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+}
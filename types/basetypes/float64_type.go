@@ -90,6 +90,17 @@ func (t Float64Type) Validate(ctx context.Context, in tftypes.Value, path path.P
 		return diags
 	}
 
+	// Infinities are valid Number values, but are not finite numbers which
+	// can be safely used by providers.
+	if value.IsInf() {
+		diags.AddAttributeError(
+			path,
+			"Float64 Type Validation Error",
+			fmt.Sprintf("Value %s is not a finite number.", value.String()),
+		)
+		return diags
+	}
+
 	float64Value, accuracy := value.Float64()
 
 	// Underflow
@@ -141,6 +152,16 @@ func (t Float64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (
 		return nil, err
 	}
 
+	return float64ValueFromBigFloat(bigF)
+}
+
+// float64ValueFromBigFloat returns a Float64Value of the given number, or an
+// error if the number is not representable as a 64-bit floating point.
+func float64ValueFromBigFloat(bigF *big.Float) (attr.Value, error) {
+	if bigF.IsInf() {
+		return nil, fmt.Errorf("Value %s is not a finite number.", bigF.String())
+	}
+
 	f, accuracy := bigF.Float64()
 
 	// Underflow
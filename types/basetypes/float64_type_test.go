@@ -79,6 +79,30 @@ func TestFloat64TypeValidate(t *testing.T) {
 			in:       tftypes.NewValue(tftypes.Number, big.NewFloat(math.MaxFloat64)),
 			expected: nil,
 		},
+		"MaxFloat64-negative": {
+			in:       tftypes.NewValue(tftypes.Number, big.NewFloat(-math.MaxFloat64)),
+			expected: nil,
+		},
+		"positive-infinity": {
+			in: tftypes.NewValue(tftypes.Number, math.Inf(1)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float64 Type Validation Error",
+					"Value +Inf is not a finite number.",
+				),
+			},
+		},
+		"negative-infinity": {
+			in: tftypes.NewValue(tftypes.Number, math.Inf(-1)),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Float64 Type Validation Error",
+					"Value -Inf is not a finite number.",
+				),
+			},
+		},
 		"MaxFloat64-above": {
 			in: tftypes.NewValue(tftypes.Number, testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
 			expected: diag.Diagnostics{
@@ -164,6 +188,14 @@ func TestFloat64TypeValueFromTerraform(t *testing.T) {
 			input:       tftypes.NewValue(tftypes.Number, big.NewFloat(math.MaxFloat64)),
 			expectation: NewFloat64Value(math.MaxFloat64),
 		},
+		"positive-infinity": {
+			input:       tftypes.NewValue(tftypes.Number, math.Inf(1)),
+			expectedErr: "Value +Inf is not a finite number.",
+		},
+		"negative-infinity": {
+			input:       tftypes.NewValue(tftypes.Number, math.Inf(-1)),
+			expectedErr: "Value -Inf is not a finite number.",
+		},
 		"MaxFloat64-above": {
 			input:       tftypes.NewValue(tftypes.Number, testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
 			expectedErr: fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1.79769313486231570814527423731704356798070e+309")),
@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -115,6 +116,11 @@ func (f Float64Value) Equal(other attr.Value) bool {
 func (f Float64Value) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 	switch f.state {
 	case attr.ValueStateKnown:
+		// NaN cannot be represented as a Number value and would panic.
+		if math.IsNaN(f.value) {
+			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), fmt.Errorf("value %g is not a finite number", f.value)
+		}
+
 		if err := tftypes.ValidateValue(tftypes.Number, f.value); err != nil {
 			return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue), err
 		}
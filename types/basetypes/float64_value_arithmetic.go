@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Add returns the sum of the Float64 and `other`. If either value is unknown,
+// an unknown Float64 is returned. An error diagnostic is returned if either
+// value is null or if the sum is not finite.
+func (f Float64Value) Add(other Float64Value) (Float64Value, diag.Diagnostics) {
+	return float64Arithmetic("add", "+", f, other, func(a, b float64) float64 { return a + b })
+}
+
+// AddMust returns the sum of the Float64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Float64 is returned.
+func (f Float64Value) AddMust(other Float64Value) Float64Value {
+	result, diags := f.Add(other)
+
+	panicOnArithmeticDiagnostics("AddMust", diags)
+
+	return result
+}
+
+// Sub returns the difference of the Float64 and `other`. If either value is
+// unknown, an unknown Float64 is returned. An error diagnostic is returned if
+// either value is null or if the difference is not finite.
+func (f Float64Value) Sub(other Float64Value) (Float64Value, diag.Diagnostics) {
+	return float64Arithmetic("subtract", "-", f, other, func(a, b float64) float64 { return a - b })
+}
+
+// SubMust returns the difference of the Float64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Float64 is returned.
+func (f Float64Value) SubMust(other Float64Value) Float64Value {
+	result, diags := f.Sub(other)
+
+	panicOnArithmeticDiagnostics("SubMust", diags)
+
+	return result
+}
+
+// Mul returns the product of the Float64 and `other`. If either value is
+// unknown, an unknown Float64 is returned. An error diagnostic is returned if
+// either value is null or if the product is not finite.
+func (f Float64Value) Mul(other Float64Value) (Float64Value, diag.Diagnostics) {
+	return float64Arithmetic("multiply", "*", f, other, func(a, b float64) float64 { return a * b })
+}
+
+// MulMust returns the product of the Float64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Float64 is returned.
+func (f Float64Value) MulMust(other Float64Value) Float64Value {
+	result, diags := f.Mul(other)
+
+	panicOnArithmeticDiagnostics("MulMust", diags)
+
+	return result
+}
+
+// Div returns the quotient of the Float64 and `other`, such as for
+// converting bytes to gigabytes. If either value is unknown, an unknown
+// Float64 is returned. An error diagnostic is returned if either value is
+// null or if the quotient is not finite, such as when dividing by zero.
+func (f Float64Value) Div(other Float64Value) (Float64Value, diag.Diagnostics) {
+	return float64Arithmetic("divide", "/", f, other, func(a, b float64) float64 { return a / b })
+}
+
+// DivMust returns the quotient of the Float64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Float64 is returned.
+func (f Float64Value) DivMust(other Float64Value) Float64Value {
+	result, diags := f.Div(other)
+
+	panicOnArithmeticDiagnostics("DivMust", diags)
+
+	return result
+}
+
+// float64Arithmetic returns the result of `fn` for the known values of `a`
+// and `b`. The `operation`, such as "add", and `operator`, such as "+", are
+// used in diagnostics.
+func float64Arithmetic(operation string, operator string, a, b Float64Value, fn func(a, b float64) float64) (Float64Value, diag.Diagnostics) {
+	if a.IsUnknown() || b.IsUnknown() {
+		return NewFloat64Unknown(), nil
+	}
+
+	diags := arithmeticNullDiagnostics("Float64", operation, a.state, b.state)
+
+	if diags.HasError() {
+		return NewFloat64Unknown(), diags
+	}
+
+	result := fn(a.value, b.value)
+
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		diags.AddError(
+			"Invalid Float64 Result",
+			fmt.Sprintf("An unexpected error was encountered trying to %s Float64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				fmt.Sprintf("The result of %g %s %g is not a finite number.", a.value, operator, b.value),
+		)
+
+		return NewFloat64Unknown(), diags
+	}
+
+	return NewFloat64Value(result), diags
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestFloat64ValueArithmetic(t *testing.T) {
+	t.Parallel()
+
+	nullDiags := func(operation string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Null Float64 Value",
+				"An unexpected error was encountered trying to "+operation+" Float64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Cannot "+operation+" a null value.",
+			),
+		}
+	}
+	invalidDiags := func(operation string, detail string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Invalid Float64 Result",
+				"An unexpected error was encountered trying to "+operation+" Float64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					detail,
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		operation     func(Float64Value, Float64Value) (Float64Value, diag.Diagnostics)
+		input         Float64Value
+		other         Float64Value
+		expected      Float64Value
+		expectedDiags diag.Diagnostics
+	}{
+		"add-known-known": {
+			operation: Float64Value.Add,
+			input:     NewFloat64Value(1.5),
+			other:     NewFloat64Value(2.25),
+			expected:  NewFloat64Value(3.75),
+		},
+		"add-known-unknown": {
+			operation: Float64Value.Add,
+			input:     NewFloat64Value(1.5),
+			other:     NewFloat64Unknown(),
+			expected:  NewFloat64Unknown(),
+		},
+		"add-null-unknown": {
+			operation: Float64Value.Add,
+			input:     NewFloat64Null(),
+			other:     NewFloat64Unknown(),
+			expected:  NewFloat64Unknown(),
+		},
+		"add-known-null": {
+			operation:     Float64Value.Add,
+			input:         NewFloat64Value(1.5),
+			other:         NewFloat64Null(),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: nullDiags("add"),
+		},
+		"add-overflow": {
+			operation:     Float64Value.Add,
+			input:         NewFloat64Value(math.MaxFloat64),
+			other:         NewFloat64Value(math.MaxFloat64),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: invalidDiags("add", "The result of 1.7976931348623157e+308 + 1.7976931348623157e+308 is not a finite number."),
+		},
+		"sub-known-known": {
+			operation: Float64Value.Sub,
+			input:     NewFloat64Value(1.5),
+			other:     NewFloat64Value(2.25),
+			expected:  NewFloat64Value(-0.75),
+		},
+		"sub-known-null": {
+			operation:     Float64Value.Sub,
+			input:         NewFloat64Null(),
+			other:         NewFloat64Value(1.5),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: nullDiags("subtract"),
+		},
+		"mul-known-known": {
+			operation: Float64Value.Mul,
+			input:     NewFloat64Value(1.5),
+			other:     NewFloat64Value(-2),
+			expected:  NewFloat64Value(-3),
+		},
+		"mul-unknown-known": {
+			operation: Float64Value.Mul,
+			input:     NewFloat64Unknown(),
+			other:     NewFloat64Value(-2),
+			expected:  NewFloat64Unknown(),
+		},
+		"mul-known-null": {
+			operation:     Float64Value.Mul,
+			input:         NewFloat64Value(1.5),
+			other:         NewFloat64Null(),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: nullDiags("multiply"),
+		},
+		"div-known-known": {
+			operation: Float64Value.Div,
+			input:     NewFloat64Value(5368709120),
+			other:     NewFloat64Value(1073741824),
+			expected:  NewFloat64Value(5),
+		},
+		"div-known-unknown": {
+			operation: Float64Value.Div,
+			input:     NewFloat64Value(1),
+			other:     NewFloat64Unknown(),
+			expected:  NewFloat64Unknown(),
+		},
+		"div-known-null": {
+			operation:     Float64Value.Div,
+			input:         NewFloat64Value(1),
+			other:         NewFloat64Null(),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: nullDiags("divide"),
+		},
+		"div-zero": {
+			operation:     Float64Value.Div,
+			input:         NewFloat64Value(1),
+			other:         NewFloat64Value(0),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: invalidDiags("divide", "The result of 1 / 0 is not a finite number."),
+		},
+		"div-zero-zero": {
+			operation:     Float64Value.Div,
+			input:         NewFloat64Value(0),
+			other:         NewFloat64Value(0),
+			expected:      NewFloat64Unknown(),
+			expectedDiags: invalidDiags("divide", "The result of 0 / 0 is not a finite number."),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.operation(testCase.input, testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFloat64ValueArithmeticMust(t *testing.T) {
+	t.Parallel()
+
+	got := NewFloat64Value(1).AddMust(NewFloat64Value(2)).SubMust(NewFloat64Value(4)).MulMust(NewFloat64Value(3)).DivMust(NewFloat64Value(2))
+
+	if !got.Equal(NewFloat64Value(-1.5)) {
+		t.Errorf("expected -1.5, got: %s", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for division by zero")
+		}
+	}()
+
+	NewFloat64Value(1).DivMust(NewFloat64Value(0))
+}
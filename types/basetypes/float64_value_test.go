@@ -35,6 +35,7 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 	type testCase struct {
 		input       Float64Value
 		expectation interface{}
+		expectedErr string
 	}
 	tests := map[string]testCase{
 		"known-int": {
@@ -45,6 +46,11 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 			input:       NewFloat64Value(123.456),
 			expectation: tftypes.NewValue(tftypes.Number, big.NewFloat(123.456)),
 		},
+		"known-nan": {
+			input:       NewFloat64Value(math.NaN()),
+			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			expectedErr: "value NaN is not a finite number",
+		},
 		"unknown": {
 			input:       NewFloat64Unknown(),
 			expectation: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
@@ -78,7 +84,17 @@ func TestFloat64ValueToTerraformValue(t *testing.T) {
 
 			got, err := test.input.ToTerraformValue(ctx)
 			if err != nil {
-				t.Errorf("Unexpected error: %s", err)
+				if test.expectedErr == "" {
+					t.Errorf("Unexpected error: %s", err)
+					return
+				}
+				if err.Error() != test.expectedErr {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, err.Error())
+					return
+				}
+			}
+			if err == nil && test.expectedErr != "" {
+				t.Errorf("Expected error to be %q, got nil", test.expectedErr)
 				return
 			}
 			if !cmp.Equal(got, test.expectation, cmp.Comparer(numberComparer)) {
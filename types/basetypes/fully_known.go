@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// IsFullyKnown returns true if the given value is known and, for collection
+// and object values, all nested elements and attributes are also known. This
+// differs from the IsUnknown method of collection and object values, which
+// only reports whether the value itself is unknown.
+//
+// Null values, including null nested elements and attributes, are considered
+// known. Returns false if the value cannot be converted to a Terraform value.
+func IsFullyKnown(ctx context.Context, v attr.Value) bool {
+	if v == nil {
+		return false
+	}
+
+	tfValue, err := v.ToTerraformValue(ctx)
+
+	if err != nil {
+		return false
+	}
+
+	return tfValue.IsFullyKnown()
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestIsFullyKnown(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name": StringType{},
+		"tags": ListType{ElemType: StringType{}},
+	}
+
+	testCases := map[string]struct {
+		input    attr.Value
+		expected bool
+	}{
+		"nil": {
+			input:    nil,
+			expected: false,
+		},
+		"primitive-known": {
+			input:    NewStringValue("test"),
+			expected: true,
+		},
+		"primitive-null": {
+			input:    NewStringNull(),
+			expected: true,
+		},
+		"primitive-unknown": {
+			input:    NewStringUnknown(),
+			expected: false,
+		},
+		"list-known": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("test"),
+					NewStringNull(),
+				},
+			),
+			expected: true,
+		},
+		"list-unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: false,
+		},
+		"list-unknown-element": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("test"),
+					NewStringUnknown(),
+				},
+			),
+			expected: false,
+		},
+		"map-unknown-element": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringUnknown(),
+				},
+			),
+			expected: false,
+		},
+		"set-unknown-element": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+				},
+			),
+			expected: false,
+		},
+		"object-known-nested": {
+			input: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"name": NewStringValue("test"),
+					"tags": NewListValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("tag"),
+						},
+					),
+				},
+			),
+			expected: true,
+		},
+		"list-object-unknown-nested-element": {
+			input: NewListValueMust(
+				ObjectType{AttrTypes: attributeTypes},
+				[]attr.Value{
+					NewObjectValueMust(
+						attributeTypes,
+						map[string]attr.Value{
+							"name": NewStringValue("test"),
+							"tags": NewListValueMust(
+								StringType{},
+								[]attr.Value{
+									NewStringValue("tag"),
+									NewStringUnknown(),
+								},
+							),
+						},
+					),
+				},
+			),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsFullyKnown(context.Background(), testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -136,6 +136,12 @@ func (t Int64Type) ValueFromTerraform(ctx context.Context, in tftypes.Value) (at
 		return nil, err
 	}
 
+	return int64ValueFromBigFloat(bigF)
+}
+
+// int64ValueFromBigFloat returns an Int64Value of the given number, or an
+// error if the number is not an integer representable as a 64-bit integer.
+func int64ValueFromBigFloat(bigF *big.Float) (attr.Value, error) {
 	if !bigF.IsInt() {
 		return nil, fmt.Errorf("Value %s is not an integer.", bigF)
 	}
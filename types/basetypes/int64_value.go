@@ -5,7 +5,11 @@ package basetypes
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -76,6 +80,66 @@ func NewInt64PointerValue(value *int64) Int64Value {
 	return NewInt64Value(*value)
 }
 
+// NewInt64ValueFromString creates a Int64 with a known value parsed from the
+// given string, such as a port number received as a string. Decimal,
+// hexadecimal with a 0x prefix, and octal with a 0o prefix are supported, each
+// with an optional leading sign. Unlike Go integer literals, a leading zero
+// without a prefix, such as 010, is decimal. An empty string creates a null
+// value.
+//
+// An error diagnostic and an unknown value are returned if the string is not
+// a supported integer form or is outside the range of an int64.
+func NewInt64ValueFromString(s string) (Int64Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if s == "" {
+		return NewInt64Null(), diags
+	}
+
+	sign, digits := "", s
+
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		sign, digits = digits[:1], digits[1:]
+	}
+
+	base := 10
+
+	switch {
+	case strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X"):
+		base, digits = 16, digits[2:]
+	case strings.HasPrefix(digits, "0o") || strings.HasPrefix(digits, "0O"):
+		base, digits = 8, digits[2:]
+	}
+
+	// ParseInt would accept a second sign after a prefix, such as 0x-1.
+	if strings.HasPrefix(digits, "-") || strings.HasPrefix(digits, "+") {
+		digits = ""
+	}
+
+	value, err := strconv.ParseInt(sign+digits, base, 64)
+
+	if errors.Is(err, strconv.ErrRange) {
+		diags.AddError(
+			"Int64 Value Out of Range",
+			fmt.Sprintf("The string %q is outside the range of a 64-bit integer, which is %d to %d.", s, int64(math.MinInt64), int64(math.MaxInt64)),
+		)
+
+		return NewInt64Unknown(), diags
+	}
+
+	if err != nil || digits == "" {
+		diags.AddError(
+			"Invalid Int64 String Value",
+			fmt.Sprintf("The string %q cannot be parsed as a 64-bit integer. ", s)+
+				"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+		)
+
+		return NewInt64Unknown(), diags
+	}
+
+	return NewInt64Value(value), diags
+}
+
 // Int64Value represents a 64-bit integer value, exposed as an int64.
 type Int64Value struct {
 	// state represents whether the value is null, unknown, or known. The
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Add returns the sum of the Int64 and `other`. If either value is unknown,
+// an unknown Int64 is returned. An error diagnostic is returned if either
+// value is null or if the sum overflows int64.
+func (i Int64Value) Add(other Int64Value) (Int64Value, diag.Diagnostics) {
+	return int64Arithmetic("add", "+", i, other, func(a, b int64) (int64, bool) {
+		result := a + b
+
+		return result, (result > a) == (b > 0)
+	})
+}
+
+// AddMust returns the sum of the Int64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Int64 is returned.
+func (i Int64Value) AddMust(other Int64Value) Int64Value {
+	result, diags := i.Add(other)
+
+	panicOnArithmeticDiagnostics("AddMust", diags)
+
+	return result
+}
+
+// Sub returns the difference of the Int64 and `other`. If either value is
+// unknown, an unknown Int64 is returned. An error diagnostic is returned if
+// either value is null or if the difference overflows int64.
+func (i Int64Value) Sub(other Int64Value) (Int64Value, diag.Diagnostics) {
+	return int64Arithmetic("subtract", "-", i, other, func(a, b int64) (int64, bool) {
+		result := a - b
+
+		return result, (result < a) == (b > 0)
+	})
+}
+
+// SubMust returns the difference of the Int64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Int64 is returned.
+func (i Int64Value) SubMust(other Int64Value) Int64Value {
+	result, diags := i.Sub(other)
+
+	panicOnArithmeticDiagnostics("SubMust", diags)
+
+	return result
+}
+
+// Mul returns the product of the Int64 and `other`. If either value is
+// unknown, an unknown Int64 is returned. An error diagnostic is returned if
+// either value is null or if the product overflows int64.
+func (i Int64Value) Mul(other Int64Value) (Int64Value, diag.Diagnostics) {
+	return int64Arithmetic("multiply", "*", i, other, func(a, b int64) (int64, bool) {
+		if a == 0 || b == 0 {
+			return 0, true
+		}
+
+		result := a * b
+
+		if (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+			return result, false
+		}
+
+		return result, result/b == a
+	})
+}
+
+// MulMust returns the product of the Int64 and `other`, converting any
+// diagnostics into a panic at runtime. If either value is unknown, an
+// unknown Int64 is returned.
+func (i Int64Value) MulMust(other Int64Value) Int64Value {
+	result, diags := i.Mul(other)
+
+	panicOnArithmeticDiagnostics("MulMust", diags)
+
+	return result
+}
+
+// int64Arithmetic returns the result of `fn` for the known values of `a` and
+// `b`, which should return false if the result overflows. The `operation`,
+// such as "add", and `operator`, such as "+", are used in diagnostics.
+func int64Arithmetic(operation string, operator string, a, b Int64Value, fn func(a, b int64) (int64, bool)) (Int64Value, diag.Diagnostics) {
+	if a.IsUnknown() || b.IsUnknown() {
+		return NewInt64Unknown(), nil
+	}
+
+	diags := arithmeticNullDiagnostics("Int64", operation, a.state, b.state)
+
+	if diags.HasError() {
+		return NewInt64Unknown(), diags
+	}
+
+	result, ok := fn(a.value, b.value)
+
+	if !ok {
+		diags.AddError(
+			"Int64 Overflow",
+			fmt.Sprintf("An unexpected error was encountered trying to %s Int64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				fmt.Sprintf("The result of %d %s %d overflows int64.", a.value, operator, b.value),
+		)
+
+		return NewInt64Unknown(), diags
+	}
+
+	return NewInt64Value(result), diags
+}
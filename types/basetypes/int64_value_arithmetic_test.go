@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestInt64ValueArithmetic(t *testing.T) {
+	t.Parallel()
+
+	nullDiags := func(operation string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Null Int64 Value",
+				"An unexpected error was encountered trying to "+operation+" Int64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"Cannot "+operation+" a null value.",
+			),
+		}
+	}
+	overflowDiags := func(operation string, detail string) diag.Diagnostics {
+		return diag.Diagnostics{
+			diag.NewErrorDiagnostic(
+				"Int64 Overflow",
+				"An unexpected error was encountered trying to "+operation+" Int64 values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					detail,
+			),
+		}
+	}
+
+	testCases := map[string]struct {
+		operation     func(Int64Value, Int64Value) (Int64Value, diag.Diagnostics)
+		input         Int64Value
+		other         Int64Value
+		expected      Int64Value
+		expectedDiags diag.Diagnostics
+	}{
+		"add-known-known": {
+			operation: Int64Value.Add,
+			input:     NewInt64Value(2),
+			other:     NewInt64Value(-5),
+			expected:  NewInt64Value(-3),
+		},
+		"add-known-unknown": {
+			operation: Int64Value.Add,
+			input:     NewInt64Value(2),
+			other:     NewInt64Unknown(),
+			expected:  NewInt64Unknown(),
+		},
+		"add-unknown-known": {
+			operation: Int64Value.Add,
+			input:     NewInt64Unknown(),
+			other:     NewInt64Value(2),
+			expected:  NewInt64Unknown(),
+		},
+		"add-unknown-null": {
+			operation: Int64Value.Add,
+			input:     NewInt64Unknown(),
+			other:     NewInt64Null(),
+			expected:  NewInt64Unknown(),
+		},
+		"add-known-null": {
+			operation:     Int64Value.Add,
+			input:         NewInt64Value(2),
+			other:         NewInt64Null(),
+			expected:      NewInt64Unknown(),
+			expectedDiags: nullDiags("add"),
+		},
+		"add-null-known": {
+			operation:     Int64Value.Add,
+			input:         NewInt64Null(),
+			other:         NewInt64Value(2),
+			expected:      NewInt64Unknown(),
+			expectedDiags: nullDiags("add"),
+		},
+		"add-max": {
+			operation: Int64Value.Add,
+			input:     NewInt64Value(math.MaxInt64 - 1),
+			other:     NewInt64Value(1),
+			expected:  NewInt64Value(math.MaxInt64),
+		},
+		"add-overflow": {
+			operation:     Int64Value.Add,
+			input:         NewInt64Value(math.MaxInt64),
+			other:         NewInt64Value(1),
+			expected:      NewInt64Unknown(),
+			expectedDiags: overflowDiags("add", "The result of 9223372036854775807 + 1 overflows int64."),
+		},
+		"add-underflow": {
+			operation:     Int64Value.Add,
+			input:         NewInt64Value(math.MinInt64),
+			other:         NewInt64Value(-1),
+			expected:      NewInt64Unknown(),
+			expectedDiags: overflowDiags("add", "The result of -9223372036854775808 + -1 overflows int64."),
+		},
+		"sub-known-known": {
+			operation: Int64Value.Sub,
+			input:     NewInt64Value(2),
+			other:     NewInt64Value(5),
+			expected:  NewInt64Value(-3),
+		},
+		"sub-known-unknown": {
+			operation: Int64Value.Sub,
+			input:     NewInt64Value(2),
+			other:     NewInt64Unknown(),
+			expected:  NewInt64Unknown(),
+		},
+		"sub-known-null": {
+			operation:     Int64Value.Sub,
+			input:         NewInt64Value(2),
+			other:         NewInt64Null(),
+			expected:      NewInt64Unknown(),
+			expectedDiags: nullDiags("subtract"),
+		},
+		"sub-overflow": {
+			operation:     Int64Value.Sub,
+			input:         NewInt64Value(math.MinInt64),
+			other:         NewInt64Value(1),
+			expected:      NewInt64Unknown(),
+			expectedDiags: overflowDiags("subtract", "The result of -9223372036854775808 - 1 overflows int64."),
+		},
+		"mul-known-known": {
+			operation: Int64Value.Mul,
+			input:     NewInt64Value(1024),
+			other:     NewInt64Value(-1024),
+			expected:  NewInt64Value(-1048576),
+		},
+		"mul-zero": {
+			operation: Int64Value.Mul,
+			input:     NewInt64Value(math.MinInt64),
+			other:     NewInt64Value(0),
+			expected:  NewInt64Value(0),
+		},
+		"mul-known-unknown": {
+			operation: Int64Value.Mul,
+			input:     NewInt64Value(2),
+			other:     NewInt64Unknown(),
+			expected:  NewInt64Unknown(),
+		},
+		"mul-known-null": {
+			operation:     Int64Value.Mul,
+			input:         NewInt64Value(2),
+			other:         NewInt64Null(),
+			expected:      NewInt64Unknown(),
+			expectedDiags: nullDiags("multiply"),
+		},
+		"mul-overflow": {
+			operation:     Int64Value.Mul,
+			input:         NewInt64Value(math.MaxInt64/2 + 1),
+			other:         NewInt64Value(2),
+			expected:      NewInt64Unknown(),
+			expectedDiags: overflowDiags("multiply", "The result of 4611686018427387904 * 2 overflows int64."),
+		},
+		"mul-overflow-min-negative-one": {
+			operation:     Int64Value.Mul,
+			input:         NewInt64Value(-1),
+			other:         NewInt64Value(math.MinInt64),
+			expected:      NewInt64Unknown(),
+			expectedDiags: overflowDiags("multiply", "The result of -1 * -9223372036854775808 overflows int64."),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.operation(testCase.input, testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestInt64ValueArithmeticMust(t *testing.T) {
+	t.Parallel()
+
+	if got := NewInt64Value(1).AddMust(NewInt64Value(2)).SubMust(NewInt64Value(4)).MulMust(NewInt64Value(3)); !got.Equal(NewInt64Value(-3)) {
+		t.Errorf("expected -3, got: %s", got)
+	}
+
+	if got := NewInt64Value(1).AddMust(NewInt64Unknown()); !got.IsUnknown() {
+		t.Errorf("expected unknown, got: %s", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for null value")
+		}
+	}()
+
+	NewInt64Value(1).AddMust(NewInt64Null())
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -352,3 +353,151 @@ func TestNewInt64PointerValue(t *testing.T) {
 		})
 	}
 }
+
+func TestNewInt64ValueFromString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      Int64Value
+		expectedDiags diag.Diagnostics
+	}{
+		"decimal": {
+			input:    "8080",
+			expected: NewInt64Value(8080),
+		},
+		"decimal-positive-sign": {
+			input:    "+8080",
+			expected: NewInt64Value(8080),
+		},
+		"negative": {
+			input:    "-42",
+			expected: NewInt64Value(-42),
+		},
+		"hexadecimal": {
+			input:    "0x1F90",
+			expected: NewInt64Value(8080),
+		},
+		"hexadecimal-uppercase-prefix": {
+			input:    "0Xff",
+			expected: NewInt64Value(255),
+		},
+		"hexadecimal-negative": {
+			input:    "-0x10",
+			expected: NewInt64Value(-16),
+		},
+		"octal": {
+			input:    "0o755",
+			expected: NewInt64Value(493),
+		},
+		"decimal-leading-zero": {
+			input:    "010",
+			expected: NewInt64Value(10),
+		},
+		"min": {
+			input:    "-9223372036854775808",
+			expected: NewInt64Value(math.MinInt64),
+		},
+		"max": {
+			input:    "0x7fffffffffffffff",
+			expected: NewInt64Value(math.MaxInt64),
+		},
+		"empty": {
+			input:    "",
+			expected: NewInt64Null(),
+		},
+		"overflow": {
+			input:    "9223372036854775808",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Int64 Value Out of Range",
+					"The string \"9223372036854775808\" is outside the range of a 64-bit integer, which is -9223372036854775808 to 9223372036854775807.",
+				),
+			},
+		},
+		"overflow-hexadecimal": {
+			input:    "0x8000000000000000",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Int64 Value Out of Range",
+					"The string \"0x8000000000000000\" is outside the range of a 64-bit integer, which is -9223372036854775808 to 9223372036854775807.",
+				),
+			},
+		},
+		"malformed": {
+			input:    "80a",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String Value",
+					"The string \"80a\" cannot be parsed as a 64-bit integer. "+
+						"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+				),
+			},
+		},
+		"malformed-prefix-only": {
+			input:    "0x",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String Value",
+					"The string \"0x\" cannot be parsed as a 64-bit integer. "+
+						"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+				),
+			},
+		},
+		"malformed-sign-after-prefix": {
+			input:    "0x-1",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String Value",
+					"The string \"0x-1\" cannot be parsed as a 64-bit integer. "+
+						"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+				),
+			},
+		},
+		"malformed-octal-digit": {
+			input:    "0o8",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String Value",
+					"The string \"0o8\" cannot be parsed as a 64-bit integer. "+
+						"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+				),
+			},
+		},
+		"malformed-whitespace": {
+			input:    " 80",
+			expected: NewInt64Unknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Int64 String Value",
+					"The string \" 80\" cannot be parsed as a 64-bit integer. "+
+						"Supported forms are decimal, hexadecimal with a 0x prefix, and octal with a 0o prefix, each with an optional leading sign.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewInt64ValueFromString(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
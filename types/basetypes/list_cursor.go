@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ListCursor decodes the elements of a List one at a time. Create one with the
+// (ListValue).Cursor method.
+type ListCursor struct {
+	// ctx is used for element conversion, as the Next method signature is
+	// intended to mirror decoding functionality which accepts only a target.
+	ctx   context.Context
+	index int
+	list  ListValue
+}
+
+// Cursor returns a ListCursor for decoding the elements of the List one at a
+// time with the Next method, rather than decoding all elements at once with
+// the ElementsAs method.
+func (l ListValue) Cursor(ctx context.Context) *ListCursor {
+	return &ListCursor{
+		ctx:  ctx,
+		list: l,
+	}
+}
+
+// Next populates `target` with the next element of the List, using the same
+// rules as the ElementsAs method, and returns true. If there are no remaining
+// elements, or the List is null, `target` is not modified and false is
+// returned. An unknown List returns false with an error diagnostic.
+func (c *ListCursor) Next(target any) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c.list.IsUnknown() {
+		diags.AddError(
+			"List Element Conversion Error",
+			"An unexpected error was encountered trying to convert list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Elements of an unknown List cannot be decoded.",
+		)
+
+		return false, diags
+	}
+
+	if c.index >= len(c.list.elements) {
+		return false, diags
+	}
+
+	elementPath := path.Empty().AtListIndex(c.index)
+	element := c.list.elements[c.index]
+	c.index++
+
+	value, err := element.ToTerraformValue(c.ctx)
+
+	if err != nil {
+		diags.AddAttributeError(
+			elementPath,
+			"List Element Conversion Error",
+			"An unexpected error was encountered trying to convert list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return true, diags
+	}
+
+	diags.Append(reflect.Into(c.ctx, c.list.elementType, value, target, reflect.Options{}, elementPath)...)
+
+	return true, diags
+}
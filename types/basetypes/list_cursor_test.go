@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestListCursorNext(t *testing.T) {
+	t.Parallel()
+
+	type element struct {
+		Name  string `tfsdk:"name"`
+		Count int64  `tfsdk:"count"`
+	}
+
+	attributeTypes := map[string]attr.Type{
+		"name":  StringType{},
+		"count": Int64Type{},
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      []element
+		expectedDiags diag.Diagnostics
+	}{
+		"objects": {
+			input: NewListValueMust(
+				ObjectType{AttrTypes: attributeTypes},
+				[]attr.Value{
+					NewObjectValueMust(
+						attributeTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("first"),
+							"count": NewInt64Value(1),
+						},
+					),
+					NewObjectValueMust(
+						attributeTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("second"),
+							"count": NewInt64Value(2),
+						},
+					),
+				},
+			),
+			expected: []element{
+				{Name: "first", Count: 1},
+				{Name: "second", Count: 2},
+			},
+		},
+		"empty": {
+			input: NewListValueMust(ObjectType{AttrTypes: attributeTypes}, []attr.Value{}),
+		},
+		"null": {
+			input: NewListNull(ObjectType{AttrTypes: attributeTypes}),
+		},
+		"unknown": {
+			input: NewListUnknown(ObjectType{AttrTypes: attributeTypes}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List Element Conversion Error",
+					"An unexpected error was encountered trying to convert list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Elements of an unknown List cannot be decoded.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []element
+			var diags diag.Diagnostics
+
+			cursor := testCase.input.Cursor(context.Background())
+
+			for {
+				var target element
+
+				ok, nextDiags := cursor.Next(&target)
+
+				diags.Append(nextDiags...)
+
+				if !ok || nextDiags.HasError() {
+					break
+				}
+
+				got = append(got, target)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
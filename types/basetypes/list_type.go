@@ -6,10 +6,12 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -30,6 +32,46 @@ type ListTypable interface {
 // property.
 type ListType struct {
 	ElemType attr.Type
+
+	// MaxElementDiagnostics, when greater than zero, limits the number of
+	// element diagnostics returned by Validate. Once the limit is reached,
+	// a single diagnostic summarizing the number of omitted diagnostics is
+	// returned instead of the remaining element diagnostics. By default,
+	// all element diagnostics are returned.
+	MaxElementDiagnostics int
+
+	// ElementValidationConcurrency, when greater than one, causes Validate
+	// to validate elements concurrently with at most this many goroutines,
+	// such as when element type validation performs network requests. When
+	// negative, Validate uses at most runtime.GOMAXPROCS goroutines, such as
+	// for CPU-bound element type validation of large collections.
+	// Diagnostics are returned in the same order as sequential validation.
+	// By default, elements are validated sequentially.
+	ElementValidationConcurrency int
+
+	// MinItems, when greater than zero, causes Validate to return an error
+	// diagnostic if a known list contains fewer elements. By default, the
+	// number of elements is not limited.
+	MinItems int
+
+	// MaxItems, when greater than zero, causes Validate to return an error
+	// diagnostic if a known list contains more elements. By default, the
+	// number of elements is not limited.
+	MaxItems int
+
+	// NumericElementCoercion, when enabled, converts Int64Type and
+	// Float64Type elements with numeric coercion, such as when state written
+	// with one numeric element type is read with another after a schema
+	// change. Validate returns a warning diagnostic for each integer element
+	// widened into a Float64Type element, and an error diagnostic for each
+	// element which cannot be converted without loss, such as an integer
+	// above 2^53 which is not exactly representable as a 64-bit floating
+	// point, or a fractional number for an Int64Type element.
+	// ValueFromTerraform also returns an error for those elements. By
+	// default, elements are converted with the ValueFromTerraform method of
+	// the element type, which rounds Float64Type elements to the nearest
+	// 64-bit floating point.
+	NumericElementCoercion bool
 }
 
 // ElementType returns the attr.Type elements will be created from.
@@ -40,7 +82,14 @@ func (l ListType) ElementType() attr.Type {
 // WithElementType returns a ListType that is identical to `l`, but with the
 // element type set to `typ`.
 func (l ListType) WithElementType(typ attr.Type) attr.TypeWithElementType {
-	return ListType{ElemType: typ}
+	return ListType{
+		ElemType:                     typ,
+		MaxElementDiagnostics:        l.MaxElementDiagnostics,
+		ElementValidationConcurrency: l.ElementValidationConcurrency,
+		MinItems:                     l.MinItems,
+		MaxItems:                     l.MaxItems,
+		NumericElementCoercion:       l.NumericElementCoercion,
+	}
 }
 
 // TerraformType returns the tftypes.Type that should be used to
@@ -54,6 +103,16 @@ func (l ListType) TerraformType(ctx context.Context) tftypes.Type {
 	}
 }
 
+// acceptsTerraformType returns true if the given type is a List whose
+// element type is accepted by ElemType, such as an object type with
+// additional attributes when ElemType is an ObjectType with
+// IgnoreUndefinedAttributes enabled.
+func (l ListType) acceptsTerraformType(ctx context.Context, typ tftypes.Type) bool {
+	listType, ok := typ.(tftypes.List)
+
+	return ok && acceptsTerraformType(ctx, l.ElemType, listType.ElementType)
+}
+
 // ValueFromTerraform returns an attr.Value given a tftypes.Value.
 // This is meant to convert the tftypes.Value into a more convenient Go
 // type for the provider to consume the data with.
@@ -61,7 +120,7 @@ func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	if in.Type() == nil {
 		return NewListNull(l.ElemType), nil
 	}
-	if !in.Type().Equal(l.TerraformType(ctx)) {
+	if !l.acceptsTerraformType(ctx, in.Type()) {
 		return nil, fmt.Errorf("can't use %s as value of List with ElementType %T, can only use %s values", in.String(), l.ElemType, l.ElemType.TerraformType(ctx).String())
 	}
 	if !in.IsKnown() {
@@ -75,6 +134,18 @@ func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	if err != nil {
 		return nil, err
 	}
+	if elems, ok, err := primitiveElementsFromTerraform(ctx, l.ElemType, l.NumericElementCoercion, val); ok {
+		if err != nil {
+			return nil, err
+		}
+		// The elements were created from the element type, so the element
+		// type checks of NewListValue are unnecessary.
+		return ListValue{
+			elementType: l.ElemType,
+			elements:    elems,
+			state:       attr.ValueStateKnown,
+		}, nil
+	}
 	elems := make([]attr.Value, 0, len(val))
 	for _, elem := range val {
 		av, err := l.ElemType.ValueFromTerraform(ctx, elem)
@@ -89,6 +160,7 @@ func (l ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 }
 
 // Equal returns true if `o` is also a ListType and has the same ElemType.
+// Validation options, such as MaxElementDiagnostics, are not considered.
 func (l ListType) Equal(o attr.Type) bool {
 	if l.ElemType == nil {
 		return false
@@ -116,7 +188,8 @@ func (l ListType) String() string {
 }
 
 // Validate validates all elements of the list that are of type
-// xattr.TypeWithValidate.
+// xattr.TypeWithValidate. If MinItems or MaxItems are set, the number of
+// elements of a known list is also validated.
 func (l ListType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -149,19 +222,72 @@ func (l ListType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		return diags
 	}
 
-	validatableType, isValidatable := l.ElemType.(xattr.TypeWithValidate)
-	if !isValidatable {
-		return diags
+	if hasValidation(l.ElemType) || l.NumericElementCoercion {
+		elemDiags := validateElements(l.ElementValidationConcurrency, len(elems), func(index int) diag.Diagnostics {
+			if !elems[index].IsFullyKnown() {
+				return nil
+			}
+			elemDiags := fwtype.Validate(ctx, l.ElemType, elems[index], path.AtListIndex(index))
+			if l.NumericElementCoercion && !elemDiags.HasError() {
+				elemDiags.Append(numericElementCoercionDiagnostics(l.ElemType, elems[index], path.AtListIndex(index))...)
+			}
+			return elemDiags
+		})
+
+		for _, d := range elemDiags {
+			diags = append(diags, d...)
+		}
+
+		diags = limitElementDiagnostics(path, diags, l.MaxElementDiagnostics)
+	}
+
+	// The element count is checked after element diagnostics, so it is never
+	// omitted by MaxElementDiagnostics.
+	if d := elementCountDiagnostic(len(elems), l.MinItems, l.MaxItems); d != nil {
+		diags.Append(diag.WithPath(path, d))
 	}
 
+	return diags
+}
+
+// ValidatablePaths returns the paths, relative to the given value, which
+// Validate would validate with the element type, without running validation.
+// Paths within elements are included when the element type also implements
+// ValidatablePaths, such as nested collections. If the element type has no
+// validation, such as an ObjectType without RequiredTogether groups, or the
+// value is null or unknown, no paths are returned. Elements which are not
+// fully known are not validated, so are not included.
+func (l ListType) ValidatablePaths(ctx context.Context, in tftypes.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return nil, diags
+	}
+
+	var elems []tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddError(
+			"List Type Validation Error",
+			"An unexpected error was encountered trying to determine the validatable paths of a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	var paths path.Paths
+
 	for index, elem := range elems {
 		if !elem.IsFullyKnown() {
 			continue
 		}
-		diags = append(diags, validatableType.Validate(ctx, elem, path.AtListIndex(index))...)
+
+		elemPaths, elemDiags := elementValidatablePaths(ctx, l.ElemType, elem, path.Empty().AtListIndex(index))
+
+		diags.Append(elemDiags...)
+		paths = append(paths, elemPaths...)
 	}
 
-	return diags
+	return paths, diags
 }
 
 // ValueType returns the Value type.
@@ -175,3 +301,162 @@ func (l ListType) ValueType(_ context.Context) attr.Value {
 func (l ListType) ValueFromList(_ context.Context, list ListValue) (ListValuable, diag.Diagnostics) {
 	return list, nil
 }
+
+// CopyValue returns a deep copy of the given known List value, with fresh
+// element storage and the same state redacted paths. The copy is converted
+// with the ValueFromList method of the value type, so custom types are
+// preserved. Elements are copied with attr.Copy.
+func (l ListType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(ListValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	list, diags := valuable.ToListValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to ListValue: %v", diags)
+	}
+
+	elements, err := copySliceValues(ctx, list.elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	list.elements = elements
+	list.stateRedactedPaths = copyPaths(list.stateRedactedPaths)
+
+	typable, ok := v.Type(ctx).(ListTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromList(ctx, list)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ListValue to ListValuable: %v", diags)
+	}
+
+	return result, nil
+}
+
+// primitiveElementConverter returns a function which converts elements when
+// the element type is exactly a primitive base type, otherwise nil. This skips
+// the per-element ValueFromTerraform interface method call for large
+// collections. The returned function reuses a single decoding variable, so
+// decoding does not allocate per element, except for NumberType elements,
+// whose values each need their own *big.Float. Custom types, including those
+// embedding a base type, return nil and must be converted with their
+// ValueFromTerraform method. String elements are interned with the string
+// interner of the context, if any, as in StringType.ValueFromTerraform.
+//
+// Int64Type and Float64Type elements are only converted when
+// `numericElementCoercion` is enabled, in which case integer Float64Type
+// elements which are not exactly representable return an error, as with the
+// NumericElementCoercion option of ListType and MapType. Otherwise nil is
+// returned for them.
+func primitiveElementConverter(ctx context.Context, elemType attr.Type, numericElementCoercion bool) func(tftypes.Value) (attr.Value, error) {
+	switch elemType.(type) {
+	case StringType:
+		interner := fwcontext.StringInterner(ctx)
+		var s string
+		return func(elem tftypes.Value) (attr.Value, error) {
+			if !elem.IsKnown() {
+				return NewStringUnknown(), nil
+			}
+			if elem.IsNull() {
+				return NewStringNull(), nil
+			}
+			if err := elem.As(&s); err != nil {
+				return nil, err
+			}
+			return NewStringValue(interner.Intern(s)), nil
+		}
+	case BoolType:
+		var b bool
+		return func(elem tftypes.Value) (attr.Value, error) {
+			if !elem.IsKnown() {
+				return NewBoolUnknown(), nil
+			}
+			if elem.IsNull() {
+				return NewBoolNull(), nil
+			}
+			if err := elem.As(&b); err != nil {
+				return nil, err
+			}
+			return NewBoolValue(b), nil
+		}
+	case Int64Type:
+		if !numericElementCoercion {
+			return nil
+		}
+		bigF := new(big.Float)
+		return func(elem tftypes.Value) (attr.Value, error) {
+			if !elem.IsKnown() {
+				return NewInt64Unknown(), nil
+			}
+			if elem.IsNull() {
+				return NewInt64Null(), nil
+			}
+			if err := elem.As(bigF); err != nil {
+				return nil, err
+			}
+			return int64ValueFromBigFloat(bigF)
+		}
+	case Float64Type:
+		if !numericElementCoercion {
+			return nil
+		}
+		bigF := new(big.Float)
+		return func(elem tftypes.Value) (attr.Value, error) {
+			if !elem.IsKnown() {
+				return NewFloat64Unknown(), nil
+			}
+			if elem.IsNull() {
+				return NewFloat64Null(), nil
+			}
+			if err := elem.As(bigF); err != nil {
+				return nil, err
+			}
+			return coercedFloat64ValueFromBigFloat(bigF)
+		}
+	case NumberType:
+		return func(elem tftypes.Value) (attr.Value, error) {
+			if !elem.IsKnown() {
+				return NewNumberUnknown(), nil
+			}
+			if elem.IsNull() {
+				return NewNumberNull(), nil
+			}
+			n := big.NewFloat(0)
+			if err := elem.As(&n); err != nil {
+				return nil, err
+			}
+			return NewNumberValue(n), nil
+		}
+	default:
+		return nil
+	}
+}
+
+// primitiveElementsFromTerraform converts the given elements with
+// primitiveElementConverter, returning true, when the element type is exactly
+// a primitive base type.
+func primitiveElementsFromTerraform(ctx context.Context, elemType attr.Type, numericElementCoercion bool, in []tftypes.Value) ([]attr.Value, bool, error) {
+	convert := primitiveElementConverter(ctx, elemType, numericElementCoercion)
+	if convert == nil {
+		return nil, false, nil
+	}
+	elems := make([]attr.Value, 0, len(in))
+	for _, elem := range in {
+		av, err := convert(elem)
+		if err != nil {
+			return nil, true, err
+		}
+		elems = append(elems, av)
+	}
+	return elems, true, nil
+}
@@ -5,10 +5,17 @@ package basetypes
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"runtime"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/stringintern"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -98,6 +105,163 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				},
 			),
 		},
+		// Int64Type, Float64Type, and NumberType share the same Terraform
+		// type, so values written with one numeric element type can be read
+		// with another. By default, Float64Type elements are rounded, while
+		// fractional Int64Type elements error.
+		"list-of-int64-numbers-as-float64": {
+			receiver: ListType{
+				ElemType: Float64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(1)),
+				tftypes.NewValue(tftypes.Number, int64(-9007199254740992)),
+			}),
+			expected: NewListValueMust(
+				Float64Type{},
+				[]attr.Value{
+					NewFloat64Value(1),
+					NewFloat64Value(-9007199254740992),
+				},
+			),
+		},
+		"list-of-float64-numbers-as-int64": {
+			receiver: ListType{
+				ElemType: Int64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, float64(1)),
+				tftypes.NewValue(tftypes.Number, float64(2)),
+			}),
+			expected: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{
+					NewInt64Value(1),
+					NewInt64Value(2),
+				},
+			),
+		},
+		"list-of-float64-numbers-as-int64-lossy": {
+			receiver: ListType{
+				ElemType: Int64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, float64(1)),
+				tftypes.NewValue(tftypes.Number, 1.5),
+			}),
+			expectedErr: fmt.Sprintf("Value %s is not an integer.", big.NewFloat(1.5)),
+		},
+		"list-of-int64-numbers-as-float64-rounded": {
+			receiver: ListType{
+				ElemType: Float64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			expected: NewListValueMust(
+				Float64Type{},
+				[]attr.Value{
+					NewFloat64Value(9007199254740992),
+				},
+			),
+		},
+		"list-of-int64-numbers-as-float64-numeric-element-coercion": {
+			receiver: ListType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(1)),
+				tftypes.NewValue(tftypes.Number, int64(9007199254740992)),
+			}),
+			expected: NewListValueMust(
+				Float64Type{},
+				[]attr.Value{
+					NewFloat64Value(1),
+					NewFloat64Value(9007199254740992),
+				},
+			),
+		},
+		"list-of-int64-numbers-as-float64-numeric-element-coercion-lossy": {
+			receiver: ListType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(1)),
+				tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			expectedErr: "Value 9007199254740993 cannot be represented exactly as a 64-bit floating point.",
+		},
+		"list-of-float64-numbers-as-int64-numeric-element-coercion-lossy": {
+			receiver: ListType{
+				ElemType:               Int64Type{},
+				NumericElementCoercion: true,
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1.5),
+			}),
+			expectedErr: fmt.Sprintf("Value %s is not an integer.", big.NewFloat(1.5)),
+		},
+		"list-of-objects-ignore-undefined-attributes": {
+			receiver: ListType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+					IgnoreUndefinedAttributes: true,
+				},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "hello"),
+					"b": tftypes.NewValue(tftypes.Bool, true),
+				}),
+			}),
+			expected: NewListValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+				},
+				[]attr.Value{
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"a": StringType{},
+						},
+						map[string]attr.Value{
+							"a": NewStringValue("hello"),
+						},
+					),
+				},
+			),
+		},
 		"unknown-list": {
 			receiver: ListType{
 				ElemType: StringType{},
@@ -152,6 +316,112 @@ func TestListTypeValueFromTerraform(t *testing.T) {
 				},
 			),
 		},
+		"list-of-bools": {
+			receiver: ListType{
+				ElemType: BoolType{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Bool,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Bool, true),
+				tftypes.NewValue(tftypes.Bool, nil),
+				tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			}),
+			expected: NewListValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+					NewBoolNull(),
+					NewBoolUnknown(),
+				},
+			),
+		},
+		"list-of-int64s": {
+			receiver: ListType{
+				ElemType: Int64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+				tftypes.NewValue(tftypes.Number, -2),
+				tftypes.NewValue(tftypes.Number, nil),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+			expected: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{
+					NewInt64Value(1),
+					NewInt64Value(-2),
+					NewInt64Null(),
+					NewInt64Unknown(),
+				},
+			),
+		},
+		"list-of-float64s": {
+			receiver: ListType{
+				ElemType: Float64Type{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1.5),
+				tftypes.NewValue(tftypes.Number, 2),
+				tftypes.NewValue(tftypes.Number, nil),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+			expected: NewListValueMust(
+				Float64Type{},
+				[]attr.Value{
+					NewFloat64Value(1.5),
+					NewFloat64Value(2),
+					NewFloat64Null(),
+					NewFloat64Unknown(),
+				},
+			),
+		},
+		"list-of-numbers": {
+			receiver: ListType{
+				ElemType: NumberType{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1.5),
+				tftypes.NewValue(tftypes.Number, 2),
+				tftypes.NewValue(tftypes.Number, nil),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			}),
+			expected: NewListValueMust(
+				NumberType{},
+				[]attr.Value{
+					NewNumberValue(big.NewFloat(1.5)),
+					NewNumberValue(big.NewFloat(2)),
+					NewNumberNull(),
+					NewNumberUnknown(),
+				},
+			),
+		},
+		"list-of-custom-strings": {
+			receiver: ListType{
+				ElemType: customStringType{},
+			},
+			input: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: NewListValueMust(
+				customStringType{},
+				[]attr.Value{
+					customStringValue{StringValue: NewStringValue("hello")},
+					customStringValue{StringValue: NewStringNull()},
+					customStringValue{StringValue: NewStringUnknown()},
+				},
+			),
+		},
 		"wrong-type": {
 			receiver: ListType{
 				ElemType: StringType{},
@@ -260,3 +530,179 @@ func TestListTypeEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestListTypeValueFromTerraformStringInterner(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elemType attr.Type
+	}{
+		"StringType": {
+			elemType: StringType{},
+		},
+		"custom-StringType": {
+			elemType: customStringType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			in := testRepeatedStringList(100)
+			listType := ListType{ElemType: testCase.elemType}
+
+			notInterned, err := listType.ValueFromTerraform(context.Background(), in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			ctx := fwcontext.WithStringInterner(context.Background(), stringintern.New(10))
+
+			interned, err := listType.ValueFromTerraform(ctx, in)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !interned.Equal(notInterned) || !notInterned.Equal(interned) {
+				t.Errorf("expected interned and non-interned values to be equal")
+			}
+
+			if diff := cmp.Diff(interned, notInterned); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testRepeatedStringList returns a list of elementCount string elements
+// drawn from 5 distinct strings. Each element has its own backing storage,
+// as if it were decoded from the protocol.
+func testRepeatedStringList(elementCount int) tftypes.Value {
+	distinct := make([]string, 0, 5)
+
+	for idx := 0; idx < 5; idx++ {
+		distinct = append(distinct, strings.Repeat(strconv.Itoa(idx), 64))
+	}
+
+	elements := make([]tftypes.Value, 0, elementCount)
+
+	for idx := 0; idx < elementCount; idx++ {
+		elements = append(elements, tftypes.NewValue(tftypes.String, string([]byte(distinct[idx%len(distinct)]))))
+	}
+
+	return tftypes.NewValue(
+		tftypes.List{
+			ElementType: tftypes.String,
+		},
+		elements,
+	)
+}
+
+var benchListValue attr.Value // Prevent compiler optimization
+
+func benchmarkListTypeValueFromTerraform(b *testing.B, elemType attr.Type, elementCount int) {
+	elements := make([]tftypes.Value, 0, elementCount)
+
+	for idx := 0; idx < elementCount; idx++ {
+		elements = append(elements, tftypes.NewValue(tftypes.String, strconv.Itoa(idx)))
+	}
+
+	var value attr.Value
+	ctx := context.Background()
+	in := tftypes.NewValue(
+		tftypes.List{
+			ElementType: tftypes.String,
+		},
+		elements,
+	)
+	list := ListType{ElemType: elemType}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var err error
+
+		value, err = list.ValueFromTerraform(ctx, in)
+
+		if err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	benchListValue = value
+}
+
+// BenchmarkListTypeValueFromTerraform50000 converts elements using the
+// primitive base type conversion.
+func BenchmarkListTypeValueFromTerraform50000(b *testing.B) {
+	benchmarkListTypeValueFromTerraform(b, StringType{}, 50000)
+}
+
+// BenchmarkListTypeValueFromTerraform50000_customType converts elements using
+// the ValueFromTerraform method of the element type, for comparison.
+func BenchmarkListTypeValueFromTerraform50000_customType(b *testing.B) {
+	benchmarkListTypeValueFromTerraform(b, customStringType{}, 50000)
+}
+
+// BenchmarkListTypeValueFromTerraform100000_repeatedStrings reports the heap
+// memory retained by a converted list of 100000 elements drawn from 5
+// distinct strings, once the protocol data is no longer referenced, with and
+// without string interning.
+func BenchmarkListTypeValueFromTerraform100000_repeatedStrings(b *testing.B) {
+	testCases := map[string]struct {
+		interner *stringintern.Cache
+	}{
+		"interning-disabled": {
+			interner: nil,
+		},
+		"interning-enabled": {
+			interner: stringintern.New(100),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		b.Run(name, func(b *testing.B) {
+			var memStats runtime.MemStats
+			var retained int64
+
+			ctx := fwcontext.WithStringInterner(context.Background(), testCase.interner)
+			list := ListType{ElemType: StringType{}}
+
+			b.ReportAllocs()
+
+			for n := 0; n < b.N; n++ {
+				b.StopTimer()
+				benchListValue = nil
+				runtime.GC()
+				runtime.ReadMemStats(&memStats)
+				before := int64(memStats.HeapAlloc)
+				in := testRepeatedStringList(100000)
+				b.StartTimer()
+
+				value, err := list.ValueFromTerraform(ctx, in)
+
+				b.StopTimer()
+
+				if err != nil {
+					b.Fatalf("unexpected error: %s", err)
+				}
+
+				benchListValue = value
+				runtime.GC()
+				runtime.ReadMemStats(&memStats)
+				retained += int64(memStats.HeapAlloc) - before
+				b.StartTimer()
+			}
+
+			b.ReportMetric(float64(retained)/float64(b.N), "retained-B/op")
+		})
+	}
+}
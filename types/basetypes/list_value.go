@@ -4,8 +4,13 @@
 package basetypes
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math/rand"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -150,6 +155,47 @@ func NewListValueMust(elementType attr.Type, elements []attr.Value) ListValue {
 	return list
 }
 
+// NewStringListFromReader creates a List with a known value, containing a
+// String element for each newline-delimited line read from `r`. The newline
+// of each line, including a carriage return preceding it, is trimmed. Empty
+// input returns an empty List. Errors reading from `r` are returned as an
+// error diagnostic with an unknown List.
+func NewStringListFromReader(r io.Reader) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	reader := bufio.NewReader(r)
+	elements := []attr.Value{}
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			diags.AddError(
+				"List Read Error",
+				"An unexpected error was encountered trying to read list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Unable to read line %d: %s", len(elements)+1, err),
+			)
+
+			return NewListUnknown(StringType{}), diags
+		}
+
+		// A final line without a trailing newline is still an element, while
+		// the empty remainder after a trailing newline is not.
+		if err == nil || line != "" {
+			line = strings.TrimSuffix(line, "\n")
+			line = strings.TrimSuffix(line, "\r")
+
+			elements = append(elements, NewStringValue(line))
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	return NewListValue(StringType{}, elements)
+}
+
 // ListValue represents a list of attr.Values, all of the same type, indicated
 // by ElemType.
 type ListValue struct {
@@ -162,6 +208,10 @@ type ListValue struct {
 	// state represents whether the value is null, unknown, or known. The
 	// zero-value is null.
 	state attr.ValueState
+
+	// stateRedactedPaths are element-relative paths which are replaced with
+	// null values when the List is written to Terraform state.
+	stateRedactedPaths []path.Path
 }
 
 // Elements returns a copy of the collection of elements for the List.
@@ -173,6 +223,18 @@ func (l ListValue) Elements() []attr.Value {
 	return result
 }
 
+// ElementValues returns a copy of the collection of elements for the List.
+// It is equivalent to Elements and implements CollectionValue.
+func (l ListValue) ElementValues() []attr.Value {
+	return l.Elements()
+}
+
+// Len returns the number of elements in the List. Null and unknown Lists
+// return zero.
+func (l ListValue) Len() int {
+	return len(l.elements)
+}
+
 // ElementsAs populates `target` with the elements of the ListValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
@@ -193,6 +255,35 @@ func (l ListValue) ElementsAs(ctx context.Context, target interface{}, allowUnha
 	}, path.Empty())
 }
 
+// ElementsAsBounded populates `target` with the elements of the List, as with
+// ElementsAs, then returns an error diagnostic if the number of elements is
+// outside the inclusive range of `minItems` to `maxItems`. A `minItems` or
+// `maxItems` of zero or less is not checked. The element count of null and
+// unknown Lists is not checked.
+func (l ListValue) ElementsAsBounded(ctx context.Context, target interface{}, allowUnhandled bool, minItems, maxItems int) diag.Diagnostics {
+	diags := l.ElementsAs(ctx, target, allowUnhandled)
+
+	if diags.HasError() || l.state != attr.ValueStateKnown {
+		return diags
+	}
+
+	if d := elementCountDiagnostic(len(l.elements), minItems, maxItems); d != nil {
+		diags.Append(d)
+	}
+
+	return diags
+}
+
+// StringSlice returns the elements of the List as Go strings without using
+// reflection, which is faster than ElementsAs for large lists. The element
+// type must be StringType and every element must be known and not null,
+// otherwise an error diagnostic is returned and ElementsAs should be used
+// instead. A null List returns a nil slice and an unknown List returns an
+// error diagnostic.
+func (l ListValue) StringSlice(_ context.Context) ([]string, diag.Diagnostics) {
+	return stringSliceFromElements("list", l.elementType, l.state, l.elements)
+}
+
 // ElementType returns the element type for the List.
 func (l ListValue) ElementType(_ context.Context) attr.Type {
 	return l.elementType
@@ -218,6 +309,12 @@ func (l ListValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error)
 				return tftypes.NewValue(listType, tftypes.UnknownValue), err
 			}
 
+			val, err = stateRedactElement(ctx, val, l.stateRedactedPaths)
+
+			if err != nil {
+				return tftypes.NewValue(listType, tftypes.UnknownValue), err
+			}
+
 			vals = append(vals, val)
 		}
 
@@ -314,3 +411,689 @@ func (l ListValue) String() string {
 func (l ListValue) ToListValue(context.Context) (ListValue, diag.Diagnostics) {
 	return l, nil
 }
+
+// TrimTrailingNull returns a copy of the List with any null elements at the
+// end of the List removed. Null elements which are followed by a non-null
+// element are preserved. Null and unknown Lists are returned unchanged.
+func (l ListValue) TrimTrailingNull(_ context.Context) (ListValue, diag.Diagnostics) {
+	if l.state != attr.ValueStateKnown {
+		return l, nil
+	}
+
+	end := len(l.elements)
+
+	for end > 0 && l.elements[end-1].IsNull() {
+		end--
+	}
+
+	list, diags := NewListValue(l.elementType, l.Elements()[:end])
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	return list, diags
+}
+
+// ToGoMap populates `target` with the object elements of the ListValue, using
+// the value of the `keyAttr` attribute of each element as the map key and the
+// value of the `valueAttr` attribute as the map value. The `target` must be a
+// pointer to a Go map with string keys, such as *map[string]int64, and the map
+// values are converted using the same rules as ElementsAs.
+//
+// The element type must be an object type containing both attributes, and the
+// `keyAttr` attribute must be a known, non-null string. Duplicate keys are
+// returned as an error diagnostic.
+func (l ListValue) ToGoMap(ctx context.Context, keyAttr, valueAttr string, target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elementType, ok := l.elementType.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		diags.AddError(
+			"List To Map Conversion Error",
+			"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List element type must be an object type, got: %s", l.elementType),
+		)
+
+		return diags
+	}
+
+	attributeTypes := elementType.AttributeTypes()
+
+	for _, attributeName := range []string{keyAttr, valueAttr} {
+		if _, ok := attributeTypes[attributeName]; !ok {
+			diags.AddError(
+				"List To Map Conversion Error",
+				"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("List element type %s does not contain attribute: %s", l.elementType, attributeName),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	valueType := attributeTypes[valueAttr]
+
+	var mapValue MapValue
+
+	switch l.state {
+	case attr.ValueStateNull:
+		mapValue = NewMapNull(valueType)
+	case attr.ValueStateUnknown:
+		mapValue = NewMapUnknown(valueType)
+	default:
+		elements := make(map[string]attr.Value, len(l.elements))
+
+		for idx, element := range l.elements {
+			objectValuable, ok := element.(ObjectValuable)
+
+			if !ok {
+				diags.AddError(
+					"List To Map Conversion Error",
+					"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						fmt.Sprintf("List Index (%d) is not an object value, got: %T", idx, element),
+				)
+
+				continue
+			}
+
+			object, objectDiags := objectValuable.ToObjectValue(ctx)
+
+			diags.Append(objectDiags...)
+
+			if objectDiags.HasError() {
+				continue
+			}
+
+			if object.IsNull() || object.IsUnknown() {
+				diags.AddError(
+					"List To Map Conversion Error",
+					fmt.Sprintf("List Index (%d) must be a known, non-null object to convert into a map, got: %s", idx, object),
+				)
+
+				continue
+			}
+
+			attributes := object.Attributes()
+
+			keyValuable, ok := attributes[keyAttr].(StringValuable)
+
+			if !ok {
+				diags.AddError(
+					"List To Map Conversion Error",
+					"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						fmt.Sprintf("List Index (%d) attribute %q is not a string value, got: %T", idx, keyAttr, attributes[keyAttr]),
+				)
+
+				continue
+			}
+
+			key, keyDiags := keyValuable.ToStringValue(ctx)
+
+			diags.Append(keyDiags...)
+
+			if keyDiags.HasError() {
+				continue
+			}
+
+			if key.IsNull() || key.IsUnknown() {
+				diags.AddError(
+					"List To Map Conversion Error",
+					fmt.Sprintf("List Index (%d) attribute %q must be a known, non-null string to be used as a map key, got: %s", idx, keyAttr, key),
+				)
+
+				continue
+			}
+
+			if _, ok := elements[key.ValueString()]; ok {
+				diags.AddError(
+					"Duplicate Map Key",
+					fmt.Sprintf("List Index (%d) attribute %q contains a duplicate map key: %s", idx, keyAttr, key.ValueString()),
+				)
+
+				continue
+			}
+
+			elements[key.ValueString()] = attributes[valueAttr]
+		}
+
+		if diags.HasError() {
+			return diags
+		}
+
+		var mapDiags diag.Diagnostics
+
+		mapValue, mapDiags = NewMapValue(valueType, elements)
+
+		diags.Append(mapDiags...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	diags.Append(mapValue.ElementsAs(ctx, target, false)...)
+
+	return diags
+}
+
+// FindByAttribute returns the first known object element of the List whose
+// `attrName` attribute value is equal to `value`, along with its index. If no
+// element matches, or the List is null or unknown, nil and -1 are returned.
+//
+// The element type must be an object type containing the attribute, otherwise
+// an error diagnostic is returned.
+func (l ListValue) FindByAttribute(ctx context.Context, attrName string, value attr.Value) (attr.Value, int, diag.Diagnostics) {
+	diags := l.validateObjectAttribute(attrName)
+
+	if diags.HasError() {
+		return nil, -1, diags
+	}
+
+	if l.state != attr.ValueStateKnown {
+		return nil, -1, diags
+	}
+
+	for idx, element := range l.elements {
+		elementAttribute, ok, elementDiags := objectElementAttribute(ctx, element, attrName)
+
+		diags.Append(elementDiags...)
+
+		if elementDiags.HasError() {
+			return nil, -1, diags
+		}
+
+		if ok && elementAttribute.Equal(value) {
+			return element, idx, diags
+		}
+	}
+
+	return nil, -1, diags
+}
+
+// IndexOf returns the index of the first element of the List equal to
+// `candidate`, using the element Equal method, or -1 if no element is equal.
+//
+// If the List is null or unknown, -1 is returned with a warning diagnostic.
+// An error diagnostic is returned if the `candidate` type does not match the
+// element type of the List.
+func (l ListValue) IndexOf(ctx context.Context, candidate attr.Value) (int, diag.Diagnostics) {
+	var candidateType attr.Type
+
+	if candidate != nil {
+		candidateType = candidate.Type(ctx)
+	}
+
+	diags := l.validateIndexOf(candidateType)
+
+	if diags.HasError() || l.state != attr.ValueStateKnown {
+		return -1, diags
+	}
+
+	for idx, element := range l.elements {
+		if element.Equal(candidate) {
+			return idx, diags
+		}
+	}
+
+	return -1, diags
+}
+
+// StringIndexOf returns the index of the first known element of the List
+// equal to the Go string `s`, or -1 if no element is equal. This is a
+// convenience for lists of StringType elements, which avoids creating an
+// attr.Value for IndexOf.
+//
+// If the List is null or unknown, -1 is returned with a warning diagnostic.
+// An error diagnostic is returned if the element type of the List is not
+// StringType.
+func (l ListValue) StringIndexOf(_ context.Context, s string) (int, diag.Diagnostics) {
+	diags := l.validateIndexOf(StringType{})
+
+	if diags.HasError() || l.state != attr.ValueStateKnown {
+		return -1, diags
+	}
+
+	for idx, element := range l.elements {
+		if value, ok := stringElementValue(element); ok && value == s {
+			return idx, diags
+		}
+	}
+
+	return -1, diags
+}
+
+// validateIndexOf returns a warning diagnostic if the List is null or
+// unknown, as it cannot contain the candidate, or an error diagnostic if the
+// candidate type does not match the element type of the List.
+func (l ListValue) validateIndexOf(candidateType attr.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch l.state {
+	case attr.ValueStateNull:
+		diags.AddWarning(
+			"Null List Value",
+			"While finding the index of a list element, the list was null, so it does not contain any elements. The index -1 is returned.",
+		)
+
+		return diags
+	case attr.ValueStateUnknown:
+		diags.AddWarning(
+			"Unknown List Value",
+			"While finding the index of a list element, the list was unknown, so its elements cannot be compared. The index -1 is returned.",
+		)
+
+		return diags
+	}
+
+	if candidateType == nil || !l.elementType.Equal(candidateType) {
+		diags.AddError(
+			"List Element Type Mismatch",
+			"An unexpected error was encountered trying to find the index of a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List Element Type: %s\n", l.elementType)+
+				fmt.Sprintf("Candidate Type: %v", candidateType),
+		)
+	}
+
+	return diags
+}
+
+// FilterObjects returns a List of the known object elements of the List whose
+// `attrName` attribute value is equal to `equals`, such as elements with a
+// matching discriminator attribute, preserving their order. Null and unknown
+// elements are skipped. Null and unknown Lists are returned unchanged.
+//
+// The element type must be an object type containing the attribute, otherwise
+// an error diagnostic is returned.
+func (l ListValue) FilterObjects(ctx context.Context, attrName string, equals attr.Value) (ListValue, diag.Diagnostics) {
+	diags := l.validateObjectAttribute(attrName)
+
+	if diags.HasError() {
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if l.state != attr.ValueStateKnown {
+		return l, diags
+	}
+
+	elements := make([]attr.Value, 0)
+
+	for _, element := range l.elements {
+		elementAttribute, ok, elementDiags := objectElementAttribute(ctx, element, attrName)
+
+		diags.Append(elementDiags...)
+
+		if elementDiags.HasError() {
+			return NewListUnknown(l.elementType), diags
+		}
+
+		if ok && elementAttribute.Equal(equals) {
+			elements = append(elements, element)
+		}
+	}
+
+	list, listDiags := NewListValue(l.elementType, elements)
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// validateObjectAttribute returns an error diagnostic if the element type of
+// the List is not an object type containing the given attribute name.
+func (l ListValue) validateObjectAttribute(attrName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	elementType, ok := l.elementType.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		diags.AddError(
+			"Invalid List Element Type",
+			"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List element type must be an object type, got: %s", l.elementType),
+		)
+
+		return diags
+	}
+
+	if _, ok := elementType.AttributeTypes()[attrName]; !ok {
+		diags.AddError(
+			"Invalid List Element Type",
+			"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List element type %s does not contain attribute: %s", l.elementType, attrName),
+		)
+	}
+
+	return diags
+}
+
+// objectElementAttribute returns the named attribute value of a known object
+// element and true. False is returned for null and unknown objects.
+func objectElementAttribute(ctx context.Context, element attr.Value, attrName string) (attr.Value, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectValuable, ok := element.(ObjectValuable)
+
+	if !ok {
+		diags.AddError(
+			"Invalid List Element Type",
+			"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("List element is not an object value, got: %T", element),
+		)
+
+		return nil, false, diags
+	}
+
+	object, diags := objectValuable.ToObjectValue(ctx)
+
+	if diags.HasError() {
+		return nil, false, diags
+	}
+
+	if object.IsNull() || object.IsUnknown() {
+		return nil, false, diags
+	}
+
+	attribute, ok := object.attributes[attrName]
+
+	return attribute, ok, diags
+}
+
+// WithStateRedactedPaths returns a copy of the List which, when written to
+// Terraform state, replaces the values at the given element-relative paths
+// with null values. Refer to newStateRedactedPaths for details.
+func (l ListValue) WithStateRedactedPaths(elementPaths ...path.Path) ListValue {
+	result := l
+	result.stateRedactedPaths = newStateRedactedPaths(elementPaths)
+
+	return result
+}
+
+// WithUnknownElements returns a copy of the List with the elements at the
+// given 0-based indices replaced by unknown values of the element type,
+// which represents a known List where only some elements are known. The
+// ValueFromTerraform and ToTerraformValue methods preserve these unknown
+// elements.
+//
+// Indices outside the bounds of the List are ignored. Null and unknown
+// Lists are returned unchanged.
+func (l ListValue) WithUnknownElements(indices []int) ListValue {
+	if l.state != attr.ValueStateKnown || len(indices) == 0 {
+		return l
+	}
+
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	unknownElement, err := l.elementType.ValueFromTerraform(ctx, tftypes.NewValue(l.elementType.TerraformType(ctx), tftypes.UnknownValue))
+
+	// Element types are expected to always support unknown values, however
+	// return the List unchanged rather than panicking.
+	if err != nil {
+		return l
+	}
+
+	result := l
+	result.elements = l.Elements()
+
+	for _, index := range indices {
+		if index < 0 || index >= len(result.elements) {
+			continue
+		}
+
+		result.elements[index] = unknownElement
+	}
+
+	return result
+}
+
+// Sample returns a List of `n` pseudo-randomly selected elements of the List,
+// preserving the relative order of the selected elements. The selection is
+// deterministic for a given `seed`, so the same List, `n`, and `seed` always
+// return the same sample. If `n` is greater than or equal to the number of
+// elements, the entire List is returned. Null and unknown Lists are returned
+// unchanged. A negative `n` returns an error diagnostic.
+func (l ListValue) Sample(_ context.Context, n int, seed int64) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if n < 0 {
+		diags.AddError(
+			"Invalid List Sample Size",
+			"An unexpected error was encountered trying to sample list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Sample size must not be negative, got: %d", n),
+		)
+
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if l.state != attr.ValueStateKnown || n >= len(l.elements) {
+		return l, diags
+	}
+
+	//nolint:gosec // Sampling is not security sensitive and must be reproducible.
+	indices := rand.New(rand.NewSource(seed)).Perm(len(l.elements))[:n]
+
+	sort.Ints(indices)
+
+	elements := make([]attr.Value, 0, n)
+
+	for _, index := range indices {
+		elements = append(elements, l.elements[index])
+	}
+
+	list, listDiags := NewListValue(l.elementType, elements)
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Head returns a List of the first `n` elements of the List. If `n` is greater
+// than or equal to the number of elements, the entire List is returned. Null
+// and unknown Lists are returned unchanged. A negative `n` returns an error
+// diagnostic.
+func (l ListValue) Head(_ context.Context, n int) (ListValue, diag.Diagnostics) {
+	diags := validateListTruncationSize("head", n)
+
+	if diags.HasError() {
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if l.state != attr.ValueStateKnown || n >= len(l.elements) {
+		return l, diags
+	}
+
+	list, listDiags := NewListValue(l.elementType, l.Elements()[:n])
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Tail returns a List of the last `n` elements of the List. If `n` is greater
+// than or equal to the number of elements, the entire List is returned. Null
+// and unknown Lists are returned unchanged. A negative `n` returns an error
+// diagnostic.
+func (l ListValue) Tail(_ context.Context, n int) (ListValue, diag.Diagnostics) {
+	diags := validateListTruncationSize("tail", n)
+
+	if diags.HasError() {
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if l.state != attr.ValueStateKnown || n >= len(l.elements) {
+		return l, diags
+	}
+
+	list, listDiags := NewListValue(l.elementType, l.Elements()[len(l.elements)-n:])
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// validateListTruncationSize returns an error diagnostic if the number of
+// elements for the Head or Tail `operation` is negative.
+func validateListTruncationSize(operation string, n int) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if n < 0 {
+		diags.AddError(
+			"Invalid List Truncation Size",
+			fmt.Sprintf("An unexpected error was encountered trying to get the %s of list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				fmt.Sprintf("Number of elements must not be negative, got: %d", n),
+		)
+	}
+
+	return diags
+}
+
+// Slice returns a List of the elements of the List from index `start` up to,
+// but not including, index `end`, with the same element type. An error
+// diagnostic is returned if the List is null or unknown, or if the range is
+// not within the bounds of the List elements.
+func (l ListValue) Slice(start, end int) (ListValue, diag.Diagnostics) {
+	diags := l.validateKnown("slice list elements")
+
+	if diags.HasError() {
+		return NewListUnknown(l.elementType), diags
+	}
+
+	if start < 0 || end < start || end > len(l.elements) {
+		diags.AddError(
+			"Invalid List Slice Range",
+			"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Range [%d:%d] is out of bounds for list with %d elements.", start, end, len(l.elements)),
+		)
+
+		return NewListUnknown(l.elementType), diags
+	}
+
+	list, listDiags := NewListValue(l.elementType, l.Elements()[start:end])
+	list.stateRedactedPaths = l.stateRedactedPaths
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Transform returns a List of the given element type, containing the result
+// of calling `fn` with each element of the List, in order. This can be used
+// to migrate elements between types, such as during state upgrades. Null and
+// unknown Lists are returned as null and unknown Lists of the given element
+// type without calling `fn`.
+//
+// Diagnostics from every call of `fn` are returned. If any contain an error,
+// or if a result does not match the given element type, an unknown List is
+// returned.
+func (l ListValue) Transform(ctx context.Context, newElementType attr.Type, fn func(attr.Value) (attr.Value, diag.Diagnostics)) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch l.state {
+	case attr.ValueStateNull:
+		return NewListNull(newElementType), diags
+	case attr.ValueStateUnknown:
+		return NewListUnknown(newElementType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(l.elements))
+
+	for _, element := range l.elements {
+		newElement, fnDiags := fn(element)
+
+		diags.Append(fnDiags...)
+
+		elements = append(elements, newElement)
+	}
+
+	if diags.HasError() {
+		return NewListUnknown(newElementType), diags
+	}
+
+	list, listDiags := NewListValue(newElementType, elements)
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// validateKnown returns an error diagnostic if the List is null or unknown,
+// describing the attempted `operation`.
+func (l ListValue) validateKnown(operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch l.state {
+	case attr.ValueStateNull:
+		diags.AddError(
+			"Null List Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				"The list is null.",
+		)
+	case attr.ValueStateUnknown:
+		diags.AddError(
+			"Unknown List Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				"The list is unknown.",
+		)
+	}
+
+	return diags
+}
+
+// ToSet returns a Set containing the elements of the List with the same
+// element type. As with NewSetValueDeduped, duplicate fully known elements,
+// including semantically equal elements, are collapsed into their first
+// occurrence, while elements that are not fully known are always kept. A warning diagnostic listing the collapsed
+// values is returned if any duplicates were removed. Null and unknown Lists
+// are returned as null and unknown Sets.
+func (l ListValue) ToSet(ctx context.Context) (SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch l.state {
+	case attr.ValueStateNull:
+		return NewSetNull(l.elementType), diags
+	case attr.ValueStateUnknown:
+		return NewSetUnknown(l.elementType), diags
+	}
+
+	deduped := make([]attr.Value, 0, len(l.elements))
+	collapsed := make([]string, 0)
+
+	index := newSetElementIndex(ctx, l.elementType)
+
+	for _, element := range l.elements {
+		added, addDiags := index.add(ctx, element)
+
+		diags.Append(addDiags...)
+
+		if addDiags.HasError() {
+			return NewSetUnknown(l.elementType), diags
+		}
+
+		if !added {
+			collapsed = append(collapsed, diag.TruncateValue(ctx, element.String()))
+
+			continue
+		}
+
+		deduped = append(deduped, element)
+	}
+
+	if len(collapsed) > 0 {
+		diags.AddWarning(
+			"Duplicate List Elements Collapsed",
+			"While converting a list into a set, duplicate elements were removed since sets cannot contain duplicate elements.\n\n"+
+				"Collapsed Values: "+strings.Join(collapsed, ", "),
+		)
+	}
+
+	set, setDiags := NewSetValue(l.elementType, deduped)
+
+	diags.Append(setDiags...)
+
+	return set, diags
+}
@@ -5,11 +5,17 @@ package basetypes
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -201,6 +207,170 @@ func TestNewListValueFrom(t *testing.T) {
 	}
 }
 
+func TestNewStringListFromReader(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         io.Reader
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"multi-line": {
+			input: strings.NewReader("hello\nworld\n"),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+		},
+		"multi-line-no-trailing-newline": {
+			input: strings.NewReader("hello\r\n\nworld"),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue(""),
+					NewStringValue("world"),
+				},
+			),
+		},
+		"single-newline": {
+			input: strings.NewReader("\n"),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue(""),
+				},
+			),
+		},
+		"empty": {
+			input:    strings.NewReader(""),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"read-error": {
+			input:    iotest.TimeoutReader(strings.NewReader("hello\n")),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List Read Error",
+					"An unexpected error was encountered trying to read list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unable to read line 2: timeout",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewStringListFromReader(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueElementsAsBounded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          ListValue
+		allowUnhandled bool
+		minItems       int
+		maxItems       int
+		expected       []string
+		expectedDiags  diag.Diagnostics
+	}{
+		"within-bounds": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 1,
+			maxItems: 2,
+			expected: []string{"hello", "world"},
+		},
+		"below-min": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 3,
+			maxItems: 5,
+			expected: []string{"hello", "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected between 3 and 5 elements, got: 2.",
+				),
+			},
+		},
+		"above-max": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 0,
+			maxItems: 1,
+			expected: []string{"hello", "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected at most 1 elements, got: 2.",
+				),
+			},
+		},
+		"null": {
+			input:          NewListNull(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown": {
+			input:          NewListUnknown(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown-disallow-unhandled": {
+			input:    NewListUnknown(StringType{}),
+			minItems: 1,
+			maxItems: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \nTarget Type: []string\nSuggested Type: basetypes.ListValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.input.ElementsAsBounded(context.Background(), &got, testCase.allowUnhandled, testCase.minItems, testCase.maxItems)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestListElementsAs_stringSlice(t *testing.T) {
 	t.Parallel()
 
@@ -831,6 +1001,35 @@ func TestListTypeValidate(t *testing.T) {
 				),
 			},
 		},
+		"map-type-key-validation-invalid": {
+			listType: ListType{
+				ElemType: lowercaseKeyMapType{
+					MapType: MapType{
+						ElemType: StringType{},
+					},
+				},
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Map{
+					ElementType: tftypes.String,
+				},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Map{
+					ElementType: tftypes.String,
+				}, map[string]tftypes.Value{
+					"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+					"TestKey": tftypes.NewValue(tftypes.String, "testvalue"),
+				}),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0).AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
 		"no-validation": {
 			listType: ListType{
 				ElemType: StringType{},
@@ -842,6 +1041,221 @@ func TestListTypeValidate(t *testing.T) {
 			}),
 			path: path.Root("test"),
 		},
+		"min-items-empty": {
+			listType: ListType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected at least 1 elements, got: 0.",
+				),
+			},
+		},
+		"min-items-satisfied": {
+			listType: ListType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			path: path.Root("test"),
+		},
+		"min-items-null": {
+			listType: ListType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, nil),
+			path: path.Root("test"),
+		},
+		"min-items-unknown": {
+			listType: ListType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, tftypes.UnknownValue),
+			path: path.Root("test"),
+		},
+		"max-items-exceeded": {
+			listType: ListType{
+				ElemType: StringType{},
+				MaxItems: 1,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected at most 1 elements, got: 2.",
+				),
+			},
+		},
+		"min-items-and-max-items": {
+			listType: ListType{
+				ElemType: Float64Type{},
+				MinItems: 2,
+				MaxItems: 3,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, 1),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected between 2 and 3 elements, got: 1.",
+				),
+			},
+		},
+		"max-element-diagnostics-unlimited": {
+			listType: ListType{
+				ElemType: Float64Type{},
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("1e400")),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("2e400")),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("3e400")),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1e400")),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("2e400")),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(2),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("3e400")),
+				),
+			},
+		},
+		"max-element-diagnostics-at-limit": {
+			listType: ListType{
+				ElemType:              Float64Type{},
+				MaxElementDiagnostics: 2,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("1e400")),
+				tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("2e400")),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1e400")),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(2),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("2e400")),
+				),
+			},
+		},
+		"max-element-diagnostics-over-limit": {
+			listType: ListType{
+				ElemType:              Float64Type{},
+				MaxElementDiagnostics: 2,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("1e400")),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("2e400")),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("3e400")),
+				tftypes.NewValue(tftypes.Number, testMustParseFloat("4e400")),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("1e400")),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Float64 Type Validation Error",
+					fmt.Sprintf("Value %s cannot be represented as a 64-bit floating point.", testMustParseFloat("2e400")),
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Too Many Element Diagnostics",
+					"Element diagnostics were limited to 2, and 2 more were omitted.",
+				),
+			},
+		},
+		"numeric-element-coercion": {
+			listType: ListType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(1)),
+				tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtListIndex(0),
+					"Numeric Element Coerced",
+					"The integer element value 1 was converted to a 64-bit floating point number.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtListIndex(1),
+					"Lossy Numeric Element Coercion",
+					"This attribute contains an element which cannot be converted to the element type without loss: "+
+						"Value 9007199254740993 cannot be represented exactly as a 64-bit floating point.",
+				),
+			},
+		},
+		"without-numeric-element-coercion": {
+			listType: ListType{
+				ElemType: Float64Type{},
+			},
+			tfValue: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.Number,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Number, int64(1)),
+				tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			path: path.Root("test"),
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -858,3 +1272,1711 @@ func TestListTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestListValueTrimTrailingNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"trailing-nulls": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+					NewStringNull(),
+					NewStringNull(),
+				},
+			),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+		},
+		"interior-nulls": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringNull(),
+					NewStringValue("world"),
+					NewStringNull(),
+				},
+			),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringNull(),
+					NewStringValue("world"),
+				},
+			),
+		},
+		"no-nulls": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+		},
+		"all-nulls": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringNull(),
+				},
+			),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"trailing-unknown": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringUnknown(),
+				},
+			),
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringUnknown(),
+				},
+			),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: NewListUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.TrimTrailingNull(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueToGoMap(t *testing.T) {
+	t.Parallel()
+
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		keyAttr       string
+		valueAttr     string
+		expected      map[string]int64
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			input: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						objectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("first"),
+							"count": NewInt64Value(1),
+						},
+					),
+					NewObjectValueMust(
+						objectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("second"),
+							"count": NewInt64Value(2),
+						},
+					),
+				},
+			),
+			keyAttr:   "name",
+			valueAttr: "count",
+			expected: map[string]int64{
+				"first":  1,
+				"second": 2,
+			},
+		},
+		"empty": {
+			input:     NewListValueMust(objectType, []attr.Value{}),
+			keyAttr:   "name",
+			valueAttr: "count",
+			expected:  map[string]int64{},
+		},
+		"null": {
+			input:     NewListNull(objectType),
+			keyAttr:   "name",
+			valueAttr: "count",
+		},
+		"duplicate-keys": {
+			input: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						objectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("first"),
+							"count": NewInt64Value(1),
+						},
+					),
+					NewObjectValueMust(
+						objectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringValue("first"),
+							"count": NewInt64Value(2),
+						},
+					),
+				},
+			),
+			keyAttr:   "name",
+			valueAttr: "count",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Map Key",
+					`List Index (1) attribute "name" contains a duplicate map key: first`,
+				),
+			},
+		},
+		"null-key": {
+			input: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						objectType.AttrTypes,
+						map[string]attr.Value{
+							"name":  NewStringNull(),
+							"count": NewInt64Value(1),
+						},
+					),
+				},
+			),
+			keyAttr:   "name",
+			valueAttr: "count",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List To Map Conversion Error",
+					`List Index (0) attribute "name" must be a known, non-null string to be used as a map key, got: <null>`,
+				),
+			},
+		},
+		"missing-attribute": {
+			input:     NewListValueMust(objectType, []attr.Value{}),
+			keyAttr:   "name",
+			valueAttr: "missing",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List To Map Conversion Error",
+					"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`List element type types.ObjectType["count":basetypes.Int64Type, "name":basetypes.StringType] does not contain attribute: missing`,
+				),
+			},
+		},
+		"non-object-element-type": {
+			input:     NewListValueMust(StringType{}, []attr.Value{}),
+			keyAttr:   "name",
+			valueAttr: "count",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List To Map Conversion Error",
+					"An unexpected error was encountered trying to convert list elements into a map. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List element type must be an object type, got: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]int64
+
+			diags := testCase.input.ToGoMap(context.Background(), testCase.keyAttr, testCase.valueAttr, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueFindByAttribute(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name":  StringType{},
+		"value": StringType{},
+	}
+	objectType := ObjectType{AttrTypes: attributeTypes}
+	first := NewObjectValueMust(
+		attributeTypes,
+		map[string]attr.Value{
+			"name":  NewStringValue("first"),
+			"value": NewStringValue("one"),
+		},
+	)
+	second := NewObjectValueMust(
+		attributeTypes,
+		map[string]attr.Value{
+			"name":  NewStringValue("second"),
+			"value": NewStringValue("two"),
+		},
+	)
+	list := NewListValueMust(
+		objectType,
+		[]attr.Value{
+			NewObjectNull(attributeTypes),
+			first,
+			second,
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ListValue
+		attrName      string
+		value         attr.Value
+		expected      attr.Value
+		expectedIndex int
+		expectedDiags diag.Diagnostics
+	}{
+		"found": {
+			input:         list,
+			attrName:      "name",
+			value:         NewStringValue("second"),
+			expected:      second,
+			expectedIndex: 2,
+		},
+		"not-found": {
+			input:         list,
+			attrName:      "name",
+			value:         NewStringValue("third"),
+			expectedIndex: -1,
+		},
+		"null": {
+			input:         NewListNull(objectType),
+			attrName:      "name",
+			value:         NewStringValue("first"),
+			expectedIndex: -1,
+		},
+		"unknown": {
+			input:         NewListUnknown(objectType),
+			attrName:      "name",
+			value:         NewStringValue("first"),
+			expectedIndex: -1,
+		},
+		"missing-attribute": {
+			input:         list,
+			attrName:      "missing",
+			value:         NewStringValue("first"),
+			expectedIndex: -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`List element type types.ObjectType["name":basetypes.StringType, "value":basetypes.StringType] does not contain attribute: missing`,
+				),
+			},
+		},
+		"non-object-element-type": {
+			input:         NewListValueMust(StringType{}, []attr.Value{NewStringValue("first")}),
+			attrName:      "name",
+			value:         NewStringValue("first"),
+			expectedIndex: -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List element type must be an object type, got: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotIndex, diags := testCase.input.FindByAttribute(context.Background(), testCase.attrName, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if gotIndex != testCase.expectedIndex {
+				t.Errorf("expected index %d, got %d", testCase.expectedIndex, gotIndex)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueIndexOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		candidate     attr.Value
+		expected      int
+		expectedDiags diag.Diagnostics
+	}{
+		"present": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("b"),
+				},
+			),
+			candidate: NewStringValue("b"),
+			expected:  1,
+		},
+		"absent": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringNull(),
+				},
+			),
+			candidate: NewStringValue("b"),
+			expected:  -1,
+		},
+		"null-candidate-element": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringNull(),
+				},
+			),
+			candidate: NewStringNull(),
+			expected:  1,
+		},
+		"null": {
+			input:     NewListNull(StringType{}),
+			candidate: NewStringValue("a"),
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Null List Value",
+					"While finding the index of a list element, the list was null, so it does not contain any elements. The index -1 is returned.",
+				),
+			},
+		},
+		"unknown": {
+			input:     NewListUnknown(StringType{}),
+			candidate: NewStringValue("a"),
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Unknown List Value",
+					"While finding the index of a list element, the list was unknown, so its elements cannot be compared. The index -1 is returned.",
+				),
+			},
+		},
+		"mismatched-candidate-type": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			candidate: NewBoolValue(true),
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List Element Type Mismatch",
+					"An unexpected error was encountered trying to find the index of a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List Element Type: basetypes.StringType\n"+
+						"Candidate Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.IndexOf(context.Background(), testCase.candidate)
+
+			if got != testCase.expected {
+				t.Errorf("expected index %d, got %d", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueStringIndexOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		candidate     string
+		expected      int
+		expectedDiags diag.Diagnostics
+	}{
+		"present": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			candidate: "b",
+			expected:  2,
+		},
+		"absent": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringNull(),
+				},
+			),
+			candidate: "",
+			expected:  -1,
+		},
+		"null": {
+			input:     NewListNull(StringType{}),
+			candidate: "a",
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Null List Value",
+					"While finding the index of a list element, the list was null, so it does not contain any elements. The index -1 is returned.",
+				),
+			},
+		},
+		"unknown": {
+			input:     NewListUnknown(StringType{}),
+			candidate: "a",
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Unknown List Value",
+					"While finding the index of a list element, the list was unknown, so its elements cannot be compared. The index -1 is returned.",
+				),
+			},
+		},
+		"mismatched-element-type": {
+			input: NewListValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			candidate: "true",
+			expected:  -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"List Element Type Mismatch",
+					"An unexpected error was encountered trying to find the index of a list element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List Element Type: basetypes.BoolType\n"+
+						"Candidate Type: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.StringIndexOf(context.Background(), testCase.candidate)
+
+			if got != testCase.expected {
+				t.Errorf("expected index %d, got %d", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueWithStateRedactedPaths(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"password": StringType{},
+		"username": StringType{},
+	}
+	objectTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"username": tftypes.String,
+		},
+	}
+	list := NewListValueMust(
+		ObjectType{AttrTypes: attributeTypes},
+		[]attr.Value{
+			NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"password": NewStringValue("secret"),
+					"username": NewStringValue("admin"),
+				},
+			),
+		},
+	)
+
+	sliced, diags := list.WithStateRedactedPaths(path.Root("password")).Slice(0, 1)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	testCases := map[string]struct {
+		input    ListValue
+		ctx      context.Context
+		expected tftypes.Value
+	}{
+		"no-redacted-paths-state": {
+			input: list,
+			ctx:   fwcontext.WithStateSerialization(context.Background()),
+			expected: tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+				tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+					"password": tftypes.NewValue(tftypes.String, "secret"),
+					"username": tftypes.NewValue(tftypes.String, "admin"),
+				}),
+			}),
+		},
+		"redacted-attribute-config": {
+			input: list.WithStateRedactedPaths(path.Root("password")),
+			ctx:   context.Background(),
+			expected: tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+				tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+					"password": tftypes.NewValue(tftypes.String, "secret"),
+					"username": tftypes.NewValue(tftypes.String, "admin"),
+				}),
+			}),
+		},
+		"redacted-attribute-state": {
+			input: list.WithStateRedactedPaths(path.Root("password")),
+			ctx:   fwcontext.WithStateSerialization(context.Background()),
+			expected: tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+				tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+					"password": tftypes.NewValue(tftypes.String, nil),
+					"username": tftypes.NewValue(tftypes.String, "admin"),
+				}),
+			}),
+		},
+		"redacted-attribute-state-slice": {
+			input: sliced,
+			ctx:   fwcontext.WithStateSerialization(context.Background()),
+			expected: tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+				tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+					"password": tftypes.NewValue(tftypes.String, nil),
+					"username": tftypes.NewValue(tftypes.String, "admin"),
+				}),
+			}),
+		},
+		"redacted-element-state": {
+			input: list.WithStateRedactedPaths(path.Empty()),
+			ctx:   fwcontext.WithStateSerialization(context.Background()),
+			expected: tftypes.NewValue(tftypes.List{ElementType: objectTfType}, []tftypes.Value{
+				tftypes.NewValue(objectTfType, nil),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ToTerraformValue(testCase.ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !testCase.input.Equal(list) {
+				t.Errorf("expected redacted paths to not affect equality")
+			}
+		})
+	}
+}
+
+func TestListValueSample(t *testing.T) {
+	t.Parallel()
+
+	elements := make([]attr.Value, 0, 10)
+
+	for i := int64(0); i < 10; i++ {
+		elements = append(elements, NewInt64Value(i))
+	}
+
+	list := NewListValueMust(Int64Type{}, elements)
+
+	testCases := map[string]struct {
+		input           ListValue
+		n               int
+		expectedLength  int
+		expectUnchanged bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"sample": {
+			input:          list,
+			n:              3,
+			expectedLength: 3,
+		},
+		"zero": {
+			input:          list,
+			n:              0,
+			expectedLength: 0,
+		},
+		"equal-length": {
+			input:           list,
+			n:               10,
+			expectedLength:  10,
+			expectUnchanged: true,
+		},
+		"greater-length": {
+			input:           list,
+			n:               20,
+			expectedLength:  10,
+			expectUnchanged: true,
+		},
+		"null": {
+			input:           NewListNull(Int64Type{}),
+			n:               3,
+			expectUnchanged: true,
+		},
+		"unknown": {
+			input:           NewListUnknown(Int64Type{}),
+			n:               3,
+			expectUnchanged: true,
+		},
+		"negative": {
+			input: list,
+			n:     -1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Sample Size",
+					"An unexpected error was encountered trying to sample list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Sample size must not be negative, got: -1",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Sample(context.Background(), testCase.n, 42)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				return
+			}
+
+			if testCase.expectUnchanged && !got.Equal(testCase.input) {
+				t.Errorf("expected unchanged list, got: %s", got)
+			}
+
+			if len(got.Elements()) != testCase.expectedLength {
+				t.Errorf("expected %d elements, got: %s", testCase.expectedLength, got)
+			}
+
+			again, _ := testCase.input.Sample(context.Background(), testCase.n, 42)
+
+			if !got.Equal(again) {
+				t.Errorf("expected deterministic sample, got %s and %s", got, again)
+			}
+		})
+	}
+}
+
+func TestListValueSample_seed(t *testing.T) {
+	t.Parallel()
+
+	elements := make([]attr.Value, 0, 100)
+
+	for i := int64(0); i < 100; i++ {
+		elements = append(elements, NewInt64Value(i))
+	}
+
+	list := NewListValueMust(Int64Type{}, elements)
+
+	first, _ := list.Sample(context.Background(), 5, 1)
+	second, _ := list.Sample(context.Background(), 5, 2)
+
+	if first.Equal(second) {
+		t.Errorf("expected different seeds to produce different samples, got %s", first)
+	}
+
+	var previous int64 = -1
+
+	for _, element := range first.Elements() {
+		value, ok := element.(Int64Value)
+
+		if !ok {
+			t.Fatalf("unexpected element type: %T", element)
+		}
+
+		if value.ValueInt64() <= previous {
+			t.Errorf("expected sample to preserve element order, got %s", first)
+		}
+
+		previous = value.ValueInt64()
+	}
+}
+
+func TestListValueHeadTail(t *testing.T) {
+	t.Parallel()
+
+	list := NewListValueMust(
+		StringType{},
+		[]attr.Value{
+			NewStringValue("a"),
+			NewStringValue("b"),
+			NewStringValue("c"),
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ListValue
+		n             int
+		expectedHead  ListValue
+		expectedTail  ListValue
+		expectedDiags func(operation string) diag.Diagnostics
+	}{
+		"partial": {
+			input: list,
+			n:     2,
+			expectedHead: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			expectedTail: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+		},
+		"zero": {
+			input:        list,
+			n:            0,
+			expectedHead: NewListValueMust(StringType{}, []attr.Value{}),
+			expectedTail: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"equal-length": {
+			input:        list,
+			n:            3,
+			expectedHead: list,
+			expectedTail: list,
+		},
+		"greater-length": {
+			input:        list,
+			n:            10,
+			expectedHead: list,
+			expectedTail: list,
+		},
+		"null": {
+			input:        NewListNull(StringType{}),
+			n:            1,
+			expectedHead: NewListNull(StringType{}),
+			expectedTail: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:        NewListUnknown(StringType{}),
+			n:            1,
+			expectedHead: NewListUnknown(StringType{}),
+			expectedTail: NewListUnknown(StringType{}),
+		},
+		"negative": {
+			input:        list,
+			n:            -1,
+			expectedHead: NewListUnknown(StringType{}),
+			expectedTail: NewListUnknown(StringType{}),
+			expectedDiags: func(operation string) diag.Diagnostics {
+				return diag.Diagnostics{
+					diag.NewErrorDiagnostic(
+						"Invalid List Truncation Size",
+						"An unexpected error was encountered trying to get the "+operation+" of list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+							"Number of elements must not be negative, got: -1",
+					),
+				}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var expectedHeadDiags, expectedTailDiags diag.Diagnostics
+
+			if testCase.expectedDiags != nil {
+				expectedHeadDiags = testCase.expectedDiags("head")
+				expectedTailDiags = testCase.expectedDiags("tail")
+			}
+
+			gotHead, diags := testCase.input.Head(context.Background(), testCase.n)
+
+			if diff := cmp.Diff(gotHead, testCase.expectedHead); diff != "" {
+				t.Errorf("unexpected Head difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, expectedHeadDiags); diff != "" {
+				t.Errorf("unexpected Head diagnostics difference: %s", diff)
+			}
+
+			gotTail, diags := testCase.input.Tail(context.Background(), testCase.n)
+
+			if diff := cmp.Diff(gotTail, testCase.expectedTail); diff != "" {
+				t.Errorf("unexpected Tail difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, expectedTailDiags); diff != "" {
+				t.Errorf("unexpected Tail diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueSlice(t *testing.T) {
+	t.Parallel()
+
+	list := NewListValueMust(
+		StringType{},
+		[]attr.Value{
+			NewStringValue("a"),
+			NewStringValue("b"),
+			NewStringValue("c"),
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ListValue
+		start         int
+		end           int
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"partial": {
+			input: list,
+			start: 1,
+			end:   3,
+			expected: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("c"),
+				},
+			),
+		},
+		"full": {
+			input:    list,
+			start:    0,
+			end:      3,
+			expected: list,
+		},
+		"empty-range": {
+			input:    list,
+			start:    1,
+			end:      1,
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"reversed-bounds": {
+			input:    list,
+			start:    2,
+			end:      1,
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Slice Range",
+					"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Range [2:1] is out of bounds for list with 3 elements.",
+				),
+			},
+		},
+		"negative-start": {
+			input:    list,
+			start:    -1,
+			end:      1,
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Slice Range",
+					"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Range [-1:1] is out of bounds for list with 3 elements.",
+				),
+			},
+		},
+		"out-of-range-end": {
+			input:    list,
+			start:    1,
+			end:      4,
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Slice Range",
+					"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Range [1:4] is out of bounds for list with 3 elements.",
+				),
+			},
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			start:    0,
+			end:      0,
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null List Value",
+					"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The list is null.",
+				),
+			},
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			start:    0,
+			end:      0,
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown List Value",
+					"An unexpected error was encountered trying to slice list elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The list is unknown.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Slice(testCase.start, testCase.end)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueTransform(t *testing.T) {
+	t.Parallel()
+
+	stringToInt64 := func(element attr.Value) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		stringValue, ok := element.(StringValue)
+
+		if !ok {
+			diags.AddError("Unexpected Element", fmt.Sprintf("got: %T", element))
+
+			return nil, diags
+		}
+
+		if stringValue.IsNull() {
+			return NewInt64Null(), diags
+		}
+
+		if stringValue.IsUnknown() {
+			return NewInt64Unknown(), diags
+		}
+
+		var i int64
+
+		if _, err := fmt.Sscanf(stringValue.ValueString(), "%d", &i); err != nil {
+			diags.AddError("Invalid Integer", fmt.Sprintf("%q is not an integer", stringValue.ValueString()))
+
+			return nil, diags
+		}
+
+		return NewInt64Value(i), diags
+	}
+
+	testCases := map[string]struct {
+		input         ListValue
+		fn            func(attr.Value) (attr.Value, diag.Diagnostics)
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"string-to-int64": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("3"),
+					NewStringNull(),
+					NewStringValue("1"),
+					NewStringUnknown(),
+				},
+			),
+			fn: stringToInt64,
+			expected: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{
+					NewInt64Value(3),
+					NewInt64Null(),
+					NewInt64Value(1),
+					NewInt64Unknown(),
+				},
+			),
+		},
+		"empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			fn:       stringToInt64,
+			expected: NewListValueMust(Int64Type{}, []attr.Value{}),
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			fn:       stringToInt64,
+			expected: NewListNull(Int64Type{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			fn:       stringToInt64,
+			expected: NewListUnknown(Int64Type{}),
+		},
+		"fn-error": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("1"),
+					NewStringValue("one"),
+					NewStringValue("two"),
+				},
+			),
+			fn:       stringToInt64,
+			expected: NewListUnknown(Int64Type{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Invalid Integer", `"one" is not an integer`),
+				diag.NewErrorDiagnostic("Invalid Integer", `"two" is not an integer`),
+			},
+		},
+		"fn-warning": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("1"),
+				},
+			),
+			fn: func(element attr.Value) (attr.Value, diag.Diagnostics) {
+				value, diags := stringToInt64(element)
+
+				diags.AddWarning("Transformed Element", "test detail")
+
+				return value, diags
+			},
+			expected: NewListValueMust(
+				Int64Type{},
+				[]attr.Value{
+					NewInt64Value(1),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Transformed Element", "test detail"),
+			},
+		},
+		"fn-wrong-element-type": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("1"),
+				},
+			),
+			fn: func(element attr.Value) (attr.Value, diag.Diagnostics) {
+				return element, nil
+			},
+			expected: NewListUnknown(Int64Type{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"While creating a List value, an invalid element was detected. "+
+						"A List must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"List Element Type: basetypes.Int64Type\n"+
+						"List Index (0) Element Type: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Transform(context.Background(), Int64Type{}, testCase.fn)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueStringSlice(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			expected: []string{"a", "b"},
+		},
+		"known-empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: []string{},
+		},
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Value",
+					"An unexpected error was encountered trying to convert list elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The list is unknown.",
+				),
+			},
+		},
+		"null-element": {
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringNull(),
+				},
+			),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Value",
+					"An unexpected error was encountered trying to convert collection elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected list element 1 to be known and not null, got: <null>. Use ElementsAs with a target type that handles null or unknown values.",
+				),
+			},
+		},
+		"wrong-element-type": {
+			input: NewListValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Type",
+					"An unexpected error was encountered trying to convert list elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected element type basetypes.StringType, got: basetypes.BoolType. Use ElementsAs for other element types.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.StringSlice(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func benchmarkStringList(n int) ListValue {
+	elements := make([]attr.Value, n)
+
+	for i := range elements {
+		elements[i] = NewStringValue(fmt.Sprintf("element-%d", i))
+	}
+
+	return NewListValueMust(StringType{}, elements)
+}
+
+func BenchmarkListValueStringSlice10000(b *testing.B) {
+	list := benchmarkStringList(10000)
+	ctx := context.Background()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, diags := list.StringSlice(ctx); diags.HasError() {
+			b.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkListValueElementsAs10000(b *testing.B) {
+	list := benchmarkStringList(10000)
+	ctx := context.Background()
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var target []string
+
+		if diags := list.ElementsAs(ctx, &target, false); diags.HasError() {
+			b.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+}
+
+func TestListValueToSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         ListValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			input:    NewListNull(StringType{}),
+			expected: NewSetNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"empty": {
+			input:    NewListValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"no-duplicates": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			}),
+		},
+		"duplicates": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+				NewStringValue("c"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Duplicate List Elements Collapsed",
+					"While converting a list into a set, duplicate elements were removed since sets cannot contain duplicate elements.\n\n"+
+						`Collapsed Values: "b", "a", "b"`,
+				),
+			},
+		},
+		"semantically-equal-duplicates": {
+			input: NewListValueMust(caseInsensitiveStringType{}, []attr.Value{
+				caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("a")},
+			}),
+			expected: NewSetValueMust(caseInsensitiveStringType{}, []attr.Value{
+				caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic(
+					"Duplicate List Elements Collapsed",
+					"While converting a list into a set, duplicate elements were removed since sets cannot contain duplicate elements.\n\n"+
+						`Collapsed Values: "a"`,
+				),
+			},
+		},
+		"unknown-elements-kept": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("a"),
+				NewStringUnknown(),
+			}),
+			expected: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("a"),
+				NewStringUnknown(),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ToSet(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueWithUnknownElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ListValue
+		indices  []int
+		expected ListValue
+	}{
+		"null": {
+			input:    NewListNull(StringType{}),
+			indices:  []int{0},
+			expected: NewListNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewListUnknown(StringType{}),
+			indices:  []int{0},
+			expected: NewListUnknown(StringType{}),
+		},
+		"no-indices": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+			}),
+			indices: nil,
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+			}),
+		},
+		"one-index": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}),
+			indices: []int{1},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringUnknown(),
+				NewStringValue("c"),
+			}),
+		},
+		"out-of-range-indices": {
+			input: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			indices: []int{-1, 0, 2},
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("b"),
+			}),
+		},
+		"object-element": {
+			input: NewListValueMust(
+				ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}},
+				[]attr.Value{
+					NewObjectValueMust(map[string]attr.Type{"a": StringType{}}, map[string]attr.Value{"a": NewStringValue("a")}),
+				},
+			),
+			indices: []int{0},
+			expected: NewListValueMust(
+				ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}},
+				[]attr.Value{
+					NewObjectUnknown(map[string]attr.Type{"a": StringType{}}),
+				},
+			),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.WithUnknownElements(testCase.indices)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValueWithUnknownElementsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	input := NewListValueMust(StringType{}, []attr.Value{
+		NewStringValue("a"),
+		NewStringValue("b"),
+		NewStringValue("c"),
+	}).WithUnknownElements([]int{1})
+
+	tfValue, err := input.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedTfValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		tftypes.NewValue(tftypes.String, "c"),
+	})
+
+	if diff := cmp.Diff(tfValue, expectedTfValue); diff != "" {
+		t.Errorf("unexpected ToTerraformValue difference: %s", diff)
+	}
+
+	got, err := ListType{ElemType: StringType{}}.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, input); diff != "" {
+		t.Errorf("unexpected ValueFromTerraform difference: %s", diff)
+	}
+
+	if got.IsUnknown() {
+		t.Error("expected known list with unknown element, got unknown list")
+	}
+}
+
+func TestListValueFilterObjects(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"type": StringType{},
+		"name": StringType{},
+	}
+	objectType := ObjectType{AttrTypes: attributeTypes}
+	newObject := func(typ, name string) ObjectValue {
+		return NewObjectValueMust(
+			attributeTypes,
+			map[string]attr.Value{
+				"type": NewStringValue(typ),
+				"name": NewStringValue(name),
+			},
+		)
+	}
+	list := NewListValueMust(
+		objectType,
+		[]attr.Value{
+			newObject("disk", "root"),
+			NewObjectNull(attributeTypes),
+			newObject("network", "eth0"),
+			NewObjectUnknown(attributeTypes),
+			newObject("disk", "data"),
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ListValue
+		attrName      string
+		equals        attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"matches": {
+			input:    list,
+			attrName: "type",
+			equals:   NewStringValue("disk"),
+			expected: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					newObject("disk", "root"),
+					newObject("disk", "data"),
+				},
+			),
+		},
+		"single-match": {
+			input:    list,
+			attrName: "type",
+			equals:   NewStringValue("network"),
+			expected: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					newObject("network", "eth0"),
+				},
+			),
+		},
+		"no-matches": {
+			input:    list,
+			attrName: "type",
+			equals:   NewStringValue("gpu"),
+			expected: NewListValueMust(objectType, []attr.Value{}),
+		},
+		"null": {
+			input:    NewListNull(objectType),
+			attrName: "type",
+			equals:   NewStringValue("disk"),
+			expected: NewListNull(objectType),
+		},
+		"unknown": {
+			input:    NewListUnknown(objectType),
+			attrName: "type",
+			equals:   NewStringValue("disk"),
+			expected: NewListUnknown(objectType),
+		},
+		"missing-attribute": {
+			input:    list,
+			attrName: "missing",
+			equals:   NewStringValue("disk"),
+			expected: NewListUnknown(objectType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`List element type types.ObjectType["name":basetypes.StringType, "type":basetypes.StringType] does not contain attribute: missing`,
+				),
+			},
+		},
+		"non-object-element-type": {
+			input:    NewListValueMust(StringType{}, []attr.Value{NewStringValue("disk")}),
+			attrName: "type",
+			equals:   NewStringValue("disk"),
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid List Element Type",
+					"An unexpected error was encountered trying to access list element attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"List element type must be an object type, got: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.FilterObjects(context.Background(), testCase.attrName, testCase.equals)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
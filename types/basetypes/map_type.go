@@ -6,10 +6,12 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -30,12 +32,63 @@ type MapTypable interface {
 // property. Keys will always be strings.
 type MapType struct {
 	ElemType attr.Type
+
+	// DisallowEmptyKeys, when enabled, causes Validate to return an error
+	// diagnostic for an empty string key in the map. By default, empty keys
+	// are permitted, as they are in Terraform.
+	DisallowEmptyKeys bool
+
+	// MaxElementDiagnostics, when greater than zero, limits the number of
+	// element diagnostics returned by Validate. Once the limit is reached,
+	// a single diagnostic summarizing the number of omitted diagnostics is
+	// returned instead of the remaining element diagnostics. By default,
+	// all element diagnostics are returned.
+	MaxElementDiagnostics int
+
+	// ElementValidationConcurrency, when greater than one, causes Validate
+	// to validate elements concurrently with at most this many goroutines,
+	// such as when element type validation performs network requests. When
+	// negative, Validate uses at most runtime.GOMAXPROCS goroutines, such as
+	// for CPU-bound element type validation of large collections.
+	// Diagnostics are returned in the same order as sequential validation.
+	// By default, elements are validated sequentially.
+	ElementValidationConcurrency int
+
+	// WireCompatibleElements, when enabled, causes ValueFromTerraform to
+	// accept element values created by ElemType whose attr.Type differs from
+	// ElemType, so long as both have the same Terraform type, such as a
+	// custom string type which creates base StringValue. Those elements are
+	// converted to ElemType with the ValueFrom method of its Typable
+	// interface, such as StringTypable ValueFromString, and an error is
+	// returned if the converted element type still differs. By default,
+	// element values must exactly match ElemType and an error is returned
+	// otherwise.
+	WireCompatibleElements bool
+
+	// NumericElementCoercion, when enabled, converts Int64Type and
+	// Float64Type elements with numeric coercion, such as when state written
+	// with one numeric element type is read with another after a schema
+	// change. Validate returns a warning diagnostic for each integer element
+	// widened into a Float64Type element, and an error diagnostic for each
+	// element which cannot be converted without loss, such as an integer
+	// above 2^53 which is not exactly representable as a 64-bit floating
+	// point, or a fractional number for an Int64Type element.
+	// ValueFromTerraform also returns an error for those elements. By
+	// default, elements are converted with the ValueFromTerraform method of
+	// the element type, which rounds Float64Type elements to the nearest
+	// 64-bit floating point.
+	NumericElementCoercion bool
 }
 
 // WithElementType returns a new copy of the type with its element type set.
 func (m MapType) WithElementType(typ attr.Type) attr.TypeWithElementType {
 	return MapType{
-		ElemType: typ,
+		ElemType:                     typ,
+		DisallowEmptyKeys:            m.DisallowEmptyKeys,
+		MaxElementDiagnostics:        m.MaxElementDiagnostics,
+		ElementValidationConcurrency: m.ElementValidationConcurrency,
+		WireCompatibleElements:       m.WireCompatibleElements,
+		NumericElementCoercion:       m.NumericElementCoercion,
 	}
 }
 
@@ -54,6 +107,16 @@ func (m MapType) TerraformType(ctx context.Context) tftypes.Type {
 	}
 }
 
+// acceptsTerraformType returns true if the given type is a Map whose element
+// type is accepted by ElemType, such as an object type with additional
+// attributes when ElemType is an ObjectType with IgnoreUndefinedAttributes
+// enabled.
+func (m MapType) acceptsTerraformType(ctx context.Context, typ tftypes.Type) bool {
+	mapType, ok := typ.(tftypes.Map)
+
+	return ok && acceptsTerraformType(ctx, m.ElemType, mapType.ElementType)
+}
+
 // ValueFromTerraform returns an attr.Value given a tftypes.Value. This is
 // meant to convert the tftypes.Value into a more convenient Go type for the
 // provider to consume the data with.
@@ -61,11 +124,15 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 	if in.Type() == nil {
 		return NewMapNull(m.ElemType), nil
 	}
-	if !in.Type().Is(tftypes.Map{}) {
+	inType, ok := in.Type().(tftypes.Map)
+	if !ok {
 		return nil, fmt.Errorf("can't use %s as value of MapValue, can only use tftypes.Map values", in.String())
 	}
-	if !in.Type().Equal(tftypes.Map{ElementType: m.ElemType.TerraformType(ctx)}) {
-		return nil, fmt.Errorf("can't use %s as value of Map with ElementType %T, can only use %s values", in.String(), m.ElemType, m.ElemType.TerraformType(ctx).String())
+	// The element type is only constructed once, as TerraformType can be
+	// expensive for deeply nested element types.
+	elemTerraformType := m.ElemType.TerraformType(ctx)
+	if !acceptsTerraformType(ctx, m.ElemType, inType.ElementType) {
+		return nil, fmt.Errorf("can't use %s as value of Map with ElementType %T, can only use tftypes.Map values with element type %s, got element type %s", in.String(), m.ElemType, elemTerraformType.String(), inType.ElementType.String())
 	}
 	if !in.IsKnown() {
 		return NewMapUnknown(m.ElemType), nil
@@ -73,12 +140,30 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 	if in.IsNull() {
 		return NewMapNull(m.ElemType), nil
 	}
-	val := map[string]tftypes.Value{}
+	// As returns the underlying elements of the value without copying them,
+	// so the only map built during conversion is the final one.
+	var val map[string]tftypes.Value
 	err := in.As(&val)
 	if err != nil {
 		return nil, err
 	}
 	elems := make(map[string]attr.Value, len(val))
+	if convert := primitiveElementConverter(ctx, m.ElemType, m.NumericElementCoercion); convert != nil {
+		for key, elem := range val {
+			av, err := convert(elem)
+			if err != nil {
+				return nil, err
+			}
+			elems[key] = av
+		}
+		// The elements were created from the element type, so the element
+		// type checks of NewMapValue are unnecessary.
+		return MapValue{
+			elementType: m.ElemType,
+			elements:    elems,
+			state:       attr.ValueStateKnown,
+		}, nil
+	}
 	for key, elem := range val {
 		av, err := m.ElemType.ValueFromTerraform(ctx, elem)
 		if err != nil {
@@ -86,12 +171,36 @@ func (m MapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr
 		}
 		elems[key] = av
 	}
-	// ValueFromTerraform above on each element should make this safe.
-	// Otherwise, this will need to do some Diagnostics to error conversion.
-	return NewMapValueMust(m.ElemType, elems), nil
+	if m.WireCompatibleElements {
+		for key, elem := range elems {
+			elemType := elem.Type(ctx)
+			if elemType.Equal(m.ElemType) {
+				continue
+			}
+			if !elemType.TerraformType(ctx).Equal(elemTerraformType) {
+				return nil, fmt.Errorf("can't use element %q of type %s as value of Map with ElementType %s, element Terraform type %s is not %s", key, elemType, m.ElemType, elemType.TerraformType(ctx), elemTerraformType)
+			}
+			converted, diags := valueFromTypable(ctx, m.ElemType, elem)
+			if diags.HasError() {
+				return nil, fmt.Errorf("can't convert element %q of type %s to Map ElementType %s: %w", key, elemType, m.ElemType, diags.ToError())
+			}
+			elems[key] = converted
+		}
+		return MapValue{
+			elementType: m.ElemType,
+			elements:    elems,
+			state:       attr.ValueStateKnown,
+		}, nil
+	}
+	mapValue, diags := NewMapValue(m.ElemType, elems)
+	if diags.HasError() {
+		return nil, diags.ToError()
+	}
+	return mapValue, nil
 }
 
 // Equal returns true if `o` is also a MapType and has the same ElemType.
+// Validation options, such as MaxElementDiagnostics, are not considered.
 func (m MapType) Equal(o attr.Type) bool {
 	if m.ElemType == nil {
 		return false
@@ -119,7 +228,8 @@ func (m MapType) String() string {
 }
 
 // Validate validates all elements of the map that are of type
-// xattr.TypeWithValidate.
+// xattr.TypeWithValidate. If DisallowEmptyKeys is enabled, the map keys must
+// also be non-empty.
 func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -152,19 +262,109 @@ func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path)
 		return diags
 	}
 
-	validatableType, isValidatable := m.ElemType.(xattr.TypeWithValidate)
-	if !isValidatable {
+	isValidatable := hasValidation(m.ElemType) || m.NumericElementCoercion
+	keyValidatableType, isKeyValidatable := m.ElemType.(xattr.TypeWithValidateKey)
+
+	// A map element type with key validation validates its own keys, rather
+	// than the keys of this map.
+	if isKeyValidatable && m.ElemType.TerraformType(ctx).Is(tftypes.Map{}) {
+		isKeyValidatable = false
+	}
+
+	if !isValidatable && !isKeyValidatable && !m.DisallowEmptyKeys {
 		return diags
 	}
 
-	for index, elem := range elems {
-		if !elem.IsFullyKnown() {
+	// Validate in key order, so diagnostics are consistently ordered.
+	keys := make([]string, 0, len(elems))
+
+	for key := range elems {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	elemDiags := validateElements(m.ElementValidationConcurrency, len(keys), func(index int) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		key := keys[index]
+		elem := elems[key]
+
+		if m.DisallowEmptyKeys && key == "" {
+			diags.AddAttributeError(
+				path.AtMapKey(key),
+				"Empty Map Key",
+				"This attribute contains an empty map key, which is not permitted.",
+			)
+		}
+
+		if isKeyValidatable {
+			diags = append(diags, keyValidatableType.ValidateKey(ctx, key, path.AtMapKey(key))...)
+		}
+
+		if !isValidatable || !elem.IsFullyKnown() {
+			return diags
+		}
+		elemDiags := fwtype.Validate(ctx, m.ElemType, elem, path.AtMapKey(key))
+		if m.NumericElementCoercion && !elemDiags.HasError() {
+			elemDiags.Append(numericElementCoercionDiagnostics(m.ElemType, elem, path.AtMapKey(key))...)
+		}
+		return append(diags, elemDiags...)
+	})
+
+	for _, d := range elemDiags {
+		diags = append(diags, d...)
+	}
+
+	return limitElementDiagnostics(path, diags, m.MaxElementDiagnostics)
+}
+
+// ValidatablePaths returns the paths, relative to the given value, which
+// Validate would validate with the element type, in key order, without running
+// validation. Paths within elements are included when the element type also
+// implements ValidatablePaths, such as nested collections. If the element type
+// has no validation, such as an ObjectType without RequiredTogether groups, or
+// the value is null or unknown, no paths are returned. Elements which are not
+// fully known are not validated, so are not included.
+func (m MapType) ValidatablePaths(ctx context.Context, in tftypes.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return nil, diags
+	}
+
+	var elems map[string]tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddError(
+			"Map Type Validation Error",
+			"An unexpected error was encountered trying to determine the validatable paths of a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	keys := make([]string, 0, len(elems))
+
+	for key := range elems {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var paths path.Paths
+
+	for _, key := range keys {
+		if !elems[key].IsFullyKnown() {
 			continue
 		}
-		diags = append(diags, validatableType.Validate(ctx, elem, path.AtMapKey(index))...)
+
+		elemPaths, elemDiags := elementValidatablePaths(ctx, m.ElemType, elems[key], path.Empty().AtMapKey(key))
+
+		diags.Append(elemDiags...)
+		paths = append(paths, elemPaths...)
 	}
 
-	return diags
+	return paths, diags
 }
 
 // ValueType returns the Value type.
@@ -178,3 +378,44 @@ func (m MapType) ValueType(_ context.Context) attr.Value {
 func (m MapType) ValueFromMap(_ context.Context, ma MapValue) (MapValuable, diag.Diagnostics) {
 	return ma, nil
 }
+
+// CopyValue returns a deep copy of the given known Map value, with fresh
+// element storage and the same state redacted paths. The copy is converted
+// with the ValueFromMap method of the value type, so custom types are
+// preserved. Elements are copied with attr.Copy.
+func (m MapType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(MapValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	ma, diags := valuable.ToMapValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to MapValue: %v", diags)
+	}
+
+	elements, err := copyMapValues(ctx, ma.elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ma.elements = elements
+	ma.stateRedactedPaths = copyPaths(ma.stateRedactedPaths)
+
+	typable, ok := v.Type(ctx).(MapTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromMap(ctx, ma)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting MapValue to MapValuable: %v", diags)
+	}
+
+	return result, nil
+}
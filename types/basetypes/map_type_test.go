@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -101,6 +102,221 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 				},
 			),
 		},
+		"float64-map-rounded": {
+			receiver: MapType{
+				ElemType: Float64Type{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Number,
+			}, map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			expected: NewMapValueMust(
+				Float64Type{},
+				map[string]attr.Value{
+					"one": NewFloat64Value(9007199254740992),
+				},
+			),
+		},
+		"float64-map-numeric-element-coercion": {
+			receiver: MapType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Number,
+			}, map[string]tftypes.Value{
+				"one":  tftypes.NewValue(tftypes.Number, int64(1)),
+				"null": tftypes.NewValue(tftypes.Number, nil),
+			}),
+			expected: NewMapValueMust(
+				Float64Type{},
+				map[string]attr.Value{
+					"one":  NewFloat64Value(1),
+					"null": NewFloat64Null(),
+				},
+			),
+		},
+		"float64-map-numeric-element-coercion-lossy": {
+			receiver: MapType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Number,
+			}, map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			expectedErr: "Value 9007199254740993 cannot be represented exactly as a 64-bit floating point.",
+		},
+		"string-map": {
+			receiver: MapType{
+				ElemType: StringType{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known":   tftypes.NewValue(tftypes.String, "one"),
+				"null":    tftypes.NewValue(tftypes.String, nil),
+				"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"known":   NewStringValue("one"),
+					"null":    NewStringNull(),
+					"unknown": NewStringUnknown(),
+				},
+			),
+		},
+		"bool-map": {
+			receiver: MapType{
+				ElemType: BoolType{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Bool,
+			}, map[string]tftypes.Value{
+				"true":  tftypes.NewValue(tftypes.Bool, true),
+				"false": tftypes.NewValue(tftypes.Bool, false),
+			}),
+			expected: NewMapValueMust(
+				BoolType{},
+				map[string]attr.Value{
+					"true":  NewBoolValue(true),
+					"false": NewBoolValue(false),
+				},
+			),
+		},
+		"custom-string-map": {
+			receiver: MapType{
+				ElemType: customStringType{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expected: NewMapValueMust(
+				customStringType{},
+				map[string]attr.Value{
+					"known": customStringValue{StringValue: NewStringValue("one")},
+				},
+			),
+		},
+		"wire-string-map": {
+			receiver: MapType{
+				ElemType: wireStringType{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expectedErr: "Invalid Map Element Type: While creating a Map value, an invalid element was detected. " +
+				"A Map must use the single, given element type. " +
+				"This is always an issue with the provider and should be reported to the provider developers.\n\n" +
+				"Map Element Type: basetypes.wireStringType\n" +
+				"Map Key (known) Element Type: basetypes.StringType",
+		},
+		"wire-string-map-wire-compatible": {
+			receiver: MapType{
+				ElemType:               wireStringType{},
+				WireCompatibleElements: true,
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known":   tftypes.NewValue(tftypes.String, "one"),
+				"null":    tftypes.NewValue(tftypes.String, nil),
+				"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: NewMapValueMust(
+				wireStringType{},
+				map[string]attr.Value{
+					"known":   wireStringValue{StringValue: NewStringValue("one")},
+					"null":    wireStringValue{StringValue: NewStringNull()},
+					"unknown": wireStringValue{StringValue: NewStringUnknown()},
+				},
+			),
+		},
+		"wire-only-string-map-wire-compatible": {
+			receiver: MapType{
+				ElemType:               wireOnlyStringType{},
+				WireCompatibleElements: true,
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expectedErr: `can't convert element "known" of type basetypes.StringType to Map ElementType basetypes.wireOnlyStringType: ` +
+				"Value Conversion Error: An unexpected error was encountered trying to convert a value to its expected type. " +
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+				"Expected Type: basetypes.wireOnlyStringType\n" +
+				"Value Type: basetypes.StringType",
+		},
+		"custom-string-map-wire-compatible": {
+			receiver: MapType{
+				ElemType:               customStringType{},
+				WireCompatibleElements: true,
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"known": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expected: NewMapValueMust(
+				customStringType{},
+				map[string]attr.Value{
+					"known": customStringValue{StringValue: NewStringValue("one")},
+				},
+			),
+		},
+		"map-of-objects-ignore-undefined-attributes": {
+			receiver: MapType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+					IgnoreUndefinedAttributes: true,
+				},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				},
+			}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "hello"),
+					"b": tftypes.NewValue(tftypes.Bool, true),
+				}),
+			}),
+			expected: NewMapValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+				},
+				map[string]attr.Value{
+					"key": NewObjectValueMust(
+						map[string]attr.Type{
+							"a": StringType{},
+						},
+						map[string]attr.Value{
+							"a": NewStringValue("hello"),
+						},
+					),
+				},
+			),
+		},
 		"wrong-type": {
 			receiver: MapType{
 				ElemType: NumberType{},
@@ -108,6 +324,17 @@ func TestMapTypeValueFromTerraform(t *testing.T) {
 			input:       tftypes.NewValue(tftypes.String, "wrong"),
 			expectedErr: `can't use tftypes.String<"wrong"> as value of MapValue, can only use tftypes.Map values`,
 		},
+		"wrong-element-type": {
+			receiver: MapType{
+				ElemType: NumberType{},
+			},
+			input: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"one": tftypes.NewValue(tftypes.String, "wrong"),
+			}),
+			expectedErr: `can't use tftypes.Map[tftypes.String]<"one":tftypes.String<"wrong">> as value of Map with ElementType basetypes.NumberType, can only use tftypes.Map values with element type tftypes.Number, got element type tftypes.String`,
+		},
 		"nil-type": {
 			receiver: MapType{
 				ElemType: NumberType{},
@@ -237,3 +464,40 @@ func TestMapTypeEqual(t *testing.T) {
 		})
 	}
 }
+
+var benchMapValue attr.Value
+
+// BenchmarkMapTypeValueFromTerraform200000 converts a map with 200,000
+// string elements.
+func BenchmarkMapTypeValueFromTerraform200000(b *testing.B) {
+	elements := make(map[string]tftypes.Value, 200000)
+
+	for idx := 0; idx < 200000; idx++ {
+		elements[strconv.Itoa(idx)] = tftypes.NewValue(tftypes.String, strconv.Itoa(idx))
+	}
+
+	var value attr.Value
+	ctx := context.Background()
+	in := tftypes.NewValue(
+		tftypes.Map{
+			ElementType: tftypes.String,
+		},
+		elements,
+	)
+	mapType := MapType{ElemType: StringType{}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		var err error
+
+		value, err = mapType.ValueFromTerraform(ctx, in)
+
+		if err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	benchMapValue = value
+}
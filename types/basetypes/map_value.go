@@ -164,6 +164,10 @@ type MapValue struct {
 	// state represents whether the value is null, unknown, or known. The
 	// zero-value is null.
 	state attr.ValueState
+
+	// stateRedactedPaths are element-relative paths which are replaced with
+	// null values when the Map is written to Terraform state.
+	stateRedactedPaths []path.Path
 }
 
 // Elements returns a copy of the mapping of elements for the Map.
@@ -178,6 +182,49 @@ func (m MapValue) Elements() map[string]attr.Value {
 	return result
 }
 
+// ElementsSortedKeys returns the keys of the Map elements, sorted in
+// increasing byte-wise order. Null and unknown Maps return an empty slice.
+func (m MapValue) ElementsSortedKeys() []string {
+	keys := make([]string, 0, len(m.elements))
+
+	for key := range m.elements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ElementValues returns a copy of the element values of the Map, in the
+// order of ElementsSortedKeys. It implements CollectionValue.
+func (m MapValue) ElementValues() []attr.Value {
+	result := make([]attr.Value, 0, len(m.elements))
+
+	for _, key := range m.ElementsSortedKeys() {
+		result = append(result, m.elements[key])
+	}
+
+	return result
+}
+
+// Len returns the number of elements in the Map. Null and unknown Maps
+// return zero.
+func (m MapValue) Len() int {
+	return len(m.elements)
+}
+
+// RangeSorted calls `f` for each key and element of the Map, in the order of
+// ElementsSortedKeys. If `f` returns false, iteration stops. Null and unknown
+// Maps have no elements, so `f` is not called.
+func (m MapValue) RangeSorted(f func(key string, val attr.Value) bool) {
+	for _, key := range m.ElementsSortedKeys() {
+		if !f(key, m.elements[key]) {
+			return
+		}
+	}
+}
+
 // ElementsAs populates `target` with the elements of the MapValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
@@ -200,6 +247,57 @@ func (m MapValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 	}, path.Empty())
 }
 
+// ElementsAsBounded populates `target` with the elements of the Map, as with
+// ElementsAs, then returns an error diagnostic if the number of elements is
+// outside the inclusive range of `minItems` to `maxItems`. A `minItems` or
+// `maxItems` of zero or less is not checked. The element count of null and
+// unknown Maps is not checked.
+func (m MapValue) ElementsAsBounded(ctx context.Context, target interface{}, allowUnhandled bool, minItems, maxItems int) diag.Diagnostics {
+	diags := m.ElementsAs(ctx, target, allowUnhandled)
+
+	if diags.HasError() || m.state != attr.ValueStateKnown {
+		return diags
+	}
+
+	if d := elementCountDiagnostic(len(m.elements), minItems, maxItems); d != nil {
+		diags.Append(d)
+	}
+
+	return diags
+}
+
+// StringMap returns the elements of the Map as Go strings without using
+// reflection, which is faster than ElementsAs for large maps. The element
+// type must be StringType and every element must be known and not null,
+// otherwise an error diagnostic is returned and ElementsAs should be used
+// instead. A null Map returns a nil map and an unknown Map returns an error
+// diagnostic.
+func (m MapValue) StringMap(_ context.Context) (map[string]string, diag.Diagnostics) {
+	diags := validateStringElementType("map", m.elementType)
+
+	diags.Append(validateStringCollectionState("map", m.state)...)
+
+	if diags.HasError() || m.state == attr.ValueStateNull {
+		return nil, diags
+	}
+
+	result := make(map[string]string, len(m.elements))
+
+	for key, element := range m.elements {
+		value, ok := stringElementValue(element)
+
+		if !ok {
+			diags.Append(invalidStringElementDiagnostics(fmt.Sprintf("map element %q", key), element)...)
+
+			return nil, diags
+		}
+
+		result[key] = value
+	}
+
+	return result, diags
+}
+
 // ElementType returns the element type for the Map.
 func (m MapValue) ElementType(_ context.Context) attr.Type {
 	return m.elementType
@@ -225,6 +323,12 @@ func (m MapValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 				return tftypes.NewValue(mapType, tftypes.UnknownValue), err
 			}
 
+			val, err = stateRedactElement(ctx, val, m.stateRedactedPaths)
+
+			if err != nil {
+				return tftypes.NewValue(mapType, tftypes.UnknownValue), err
+			}
+
 			vals[key] = val
 		}
 
@@ -279,6 +383,49 @@ func (m MapValue) Equal(o attr.Value) bool {
 	return true
 }
 
+// EqualTreatingMissingAsNull returns true if the given MapValue has the same
+// element type, same value state, and contains equal element values as
+// defined by the Equal method of the element type, except that a key with a
+// null element value in one Map is considered equal to that key being absent
+// from the other Map.
+func (m MapValue) EqualTreatingMissingAsNull(other MapValue) bool {
+	if !m.elementType.Equal(other.elementType) {
+		return false
+	}
+
+	if m.state != other.state {
+		return false
+	}
+
+	if m.state != attr.ValueStateKnown {
+		return true
+	}
+
+	for key, mElem := range m.elements {
+		otherElem, ok := other.elements[key]
+
+		if !ok {
+			if !mElem.IsNull() {
+				return false
+			}
+
+			continue
+		}
+
+		if !mElem.Equal(otherElem) {
+			return false
+		}
+	}
+
+	for key, otherElem := range other.elements {
+		if _, ok := m.elements[key]; !ok && !otherElem.IsNull() {
+			return false
+		}
+	}
+
+	return true
+}
+
 // IsNull returns true if the Map represents a null value.
 func (m MapValue) IsNull() bool {
 	return m.state == attr.ValueStateNull
@@ -304,11 +451,7 @@ func (m MapValue) String() string {
 	}
 
 	// We want the output to be consistent, so we sort the output by key
-	keys := make([]string, 0, len(m.Elements()))
-	for k := range m.Elements() {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
+	keys := m.ElementsSortedKeys()
 
 	var res strings.Builder
 
@@ -328,3 +471,261 @@ func (m MapValue) String() string {
 func (m MapValue) ToMapValue(context.Context) (MapValue, diag.Diagnostics) {
 	return m, nil
 }
+
+// ConvertValues returns a copy of the Map with each element converted to the
+// given element type via its ValueFromTerraform method. This can be used to
+// convert between element types which share the same Terraform type, such as
+// a base string type and a custom string type. Null and unknown Maps are
+// returned as null and unknown Maps of the given element type.
+//
+// An error diagnostic is returned for each element which cannot be converted.
+func (m MapValue) ConvertValues(ctx context.Context, targetElemType attr.Type) (MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch m.state {
+	case attr.ValueStateNull:
+		return NewMapNull(targetElemType), diags
+	case attr.ValueStateUnknown:
+		return NewMapUnknown(targetElemType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(m.elements))
+
+	for key, element := range m.elements {
+		tfValue, err := element.ToTerraformValue(ctx)
+
+		if err == nil {
+			elements[key], err = targetElemType.ValueFromTerraform(ctx, tfValue)
+		}
+
+		if err != nil {
+			diags.AddError(
+				"Map Element Conversion Error",
+				"An unexpected error was encountered trying to convert a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Map Key (%s) could not be converted to %s: %s", key, targetElemType, err),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewMapUnknown(targetElemType), diags
+	}
+
+	return NewMapValue(targetElemType, elements)
+}
+
+// WithStateRedactedPaths returns a copy of the Map which, when written to
+// Terraform state, replaces the values at the given element-relative paths
+// with null values. Refer to newStateRedactedPaths for details.
+func (m MapValue) WithStateRedactedPaths(elementPaths ...path.Path) MapValue {
+	result := m
+	result.stateRedactedPaths = newStateRedactedPaths(elementPaths)
+
+	return result
+}
+
+// Diff compares the Map with the `prior` Map, returning the elements of keys
+// only present in the Map as `added`, the elements of keys only present in
+// `prior` as `removed`, and the prior and current elements of keys present in
+// both Maps with unequal elements as `changed`. Null Maps are compared as
+// Maps without elements.
+//
+// An error diagnostic is returned if either Map is unknown or if the element
+// types of the Maps do not match.
+func (m MapValue) Diff(prior MapValue) (added map[string]attr.Value, removed map[string]attr.Value, changed map[string][2]attr.Value, diags diag.Diagnostics) {
+	if !m.elementType.Equal(prior.elementType) {
+		diags.AddError(
+			"Map Diff Error",
+			"An unexpected error was encountered trying to compare maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Map Element Type: %s\n", m.elementType)+
+				fmt.Sprintf("Prior Map Element Type: %s", prior.elementType),
+		)
+
+		return nil, nil, nil, diags
+	}
+
+	if m.IsUnknown() || prior.IsUnknown() {
+		diags.AddError(
+			"Map Diff Error",
+			"An unexpected error was encountered trying to compare maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Unknown maps cannot be compared.",
+		)
+
+		return nil, nil, nil, diags
+	}
+
+	added = make(map[string]attr.Value)
+	removed = make(map[string]attr.Value)
+	changed = make(map[string][2]attr.Value)
+
+	for key, elem := range m.elements {
+		priorElem, ok := prior.elements[key]
+
+		if !ok {
+			added[key] = elem
+
+			continue
+		}
+
+		if !elem.Equal(priorElem) {
+			changed[key] = [2]attr.Value{priorElem, elem}
+		}
+	}
+
+	for key, priorElem := range prior.elements {
+		if _, ok := m.elements[key]; !ok {
+			removed[key] = priorElem
+		}
+	}
+
+	return added, removed, changed, diags
+}
+
+// ConflictPolicy determines the element kept by MapValue Merge when both Maps
+// contain the same key with unequal elements.
+type ConflictPolicy uint8
+
+const (
+	// ConflictPolicyPreferReceiver keeps the element of the Map on which
+	// Merge is called.
+	ConflictPolicyPreferReceiver ConflictPolicy = 0
+
+	// ConflictPolicyPreferOther keeps the element of the Map given to Merge.
+	ConflictPolicyPreferOther ConflictPolicy = 1
+
+	// ConflictPolicyError returns an error diagnostic for each conflicting
+	// key.
+	ConflictPolicyError ConflictPolicy = 2
+)
+
+// Merge returns a Map containing the elements of both the Map and `other`.
+// Keys contained in both Maps with unequal elements are resolved with
+// `onConflict`, while keys with equal elements are not conflicts. Null Maps
+// are treated as Maps without elements, although merging two null Maps
+// returns a null Map. If either Map is unknown, an unknown Map is returned.
+//
+// An error diagnostic is returned if the element types of the Maps do not
+// match, or for each conflicting key with ConflictPolicyError.
+func (m MapValue) Merge(ctx context.Context, other MapValue, onConflict ConflictPolicy) (MapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !m.elementType.Equal(other.elementType) {
+		diags.AddError(
+			"Map Merge Error",
+			"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Map Element Type: %s\n", m.elementType)+
+				fmt.Sprintf("Other Map Element Type: %s", other.elementType),
+		)
+
+		return NewMapUnknown(m.elementType), diags
+	}
+
+	if m.IsUnknown() || other.IsUnknown() {
+		return NewMapUnknown(m.elementType), diags
+	}
+
+	if m.IsNull() && other.IsNull() {
+		return NewMapNull(m.elementType), diags
+	}
+
+	elements := make(map[string]attr.Value, len(m.elements)+len(other.elements))
+
+	for key, elem := range m.elements {
+		elements[key] = elem
+	}
+
+	for _, key := range other.ElementsSortedKeys() {
+		otherElem := other.elements[key]
+		elem, ok := elements[key]
+
+		if !ok || elem.Equal(otherElem) {
+			elements[key] = otherElem
+
+			continue
+		}
+
+		switch onConflict {
+		case ConflictPolicyPreferReceiver:
+			// The receiver element is already in elements.
+		case ConflictPolicyPreferOther:
+			elements[key] = otherElem
+		case ConflictPolicyError:
+			diags.AddError(
+				"Map Merge Conflict",
+				"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Map Key (%s) has conflicting elements: %s and %s", key, diag.TruncateValue(ctx, elem.String()), diag.TruncateValue(ctx, otherElem.String())),
+			)
+		default:
+			diags.AddError(
+				"Map Merge Error",
+				"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Unhandled ConflictPolicy: %d", onConflict),
+			)
+
+			return NewMapUnknown(m.elementType), diags
+		}
+	}
+
+	if diags.HasError() {
+		return NewMapUnknown(m.elementType), diags
+	}
+
+	result, resultDiags := NewMapValue(m.elementType, elements)
+	result.stateRedactedPaths = combineStateRedactedPaths(m.stateRedactedPaths, other.stateRedactedPaths)
+
+	diags.Append(resultDiags...)
+
+	return result, diags
+}
+
+// FilterKeys returns a Map containing only the elements of the Map with the
+// given keys, such as to select a subset of tags. Keys not contained in the
+// Map are ignored, so the result may be an empty Map. Null and unknown Maps
+// are returned unchanged.
+func (m MapValue) FilterKeys(keys []string) (MapValue, diag.Diagnostics) {
+	if m.state != attr.ValueStateKnown {
+		return m, nil
+	}
+
+	elements := make(map[string]attr.Value, len(keys))
+
+	for _, key := range keys {
+		if elem, ok := m.elements[key]; ok {
+			elements[key] = elem
+		}
+	}
+
+	result, diags := NewMapValue(m.elementType, elements)
+	result.stateRedactedPaths = m.stateRedactedPaths
+
+	return result, diags
+}
+
+// WithoutKeys returns a Map containing the elements of the Map except those
+// with the given keys, such as to remove system managed tags. Keys not
+// contained in the Map are ignored. Null and unknown Maps are returned
+// unchanged.
+func (m MapValue) WithoutKeys(keys []string) (MapValue, diag.Diagnostics) {
+	if m.state != attr.ValueStateKnown {
+		return m, nil
+	}
+
+	excluded := make(map[string]struct{}, len(keys))
+
+	for _, key := range keys {
+		excluded[key] = struct{}{}
+	}
+
+	elements := make(map[string]attr.Value, len(m.elements))
+
+	for key, elem := range m.elements {
+		if _, ok := excluded[key]; !ok {
+			elements[key] = elem
+		}
+	}
+
+	result, diags := NewMapValue(m.elementType, elements)
+	result.stateRedactedPaths = m.stateRedactedPaths
+
+	return result, diags
+}
@@ -5,11 +5,14 @@ package basetypes
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -201,6 +204,96 @@ func TestNewMapValueFrom(t *testing.T) {
 	}
 }
 
+func TestMapValueElementsAsBounded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          MapValue
+		allowUnhandled bool
+		minItems       int
+		maxItems       int
+		expected       map[string]string
+		expectedDiags  diag.Diagnostics
+	}{
+		"within-bounds": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"h": NewStringValue("hello"), "w": NewStringValue("world")}),
+			minItems: 1,
+			maxItems: 2,
+			expected: map[string]string{"h": "hello", "w": "world"},
+		},
+		"below-min": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"h": NewStringValue("hello"), "w": NewStringValue("world")}),
+			minItems: 3,
+			maxItems: 5,
+			expected: map[string]string{"h": "hello", "w": "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected between 3 and 5 elements, got: 2.",
+				),
+			},
+		},
+		"above-max": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{"h": NewStringValue("hello"), "w": NewStringValue("world")}),
+			minItems: 0,
+			maxItems: 1,
+			expected: map[string]string{"h": "hello", "w": "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected at most 1 elements, got: 2.",
+				),
+			},
+		},
+		"null": {
+			input:          NewMapNull(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown": {
+			input:          NewMapUnknown(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown-disallow-unhandled": {
+			input:    NewMapUnknown(StringType{}),
+			minItems: 1,
+			maxItems: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \nTarget Type: map[string]string\nSuggested Type: basetypes.MapValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got map[string]string
+
+			diags := testCase.input.ElementsAsBounded(context.Background(), &got, testCase.allowUnhandled, testCase.minItems, testCase.maxItems)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapElementsAs_mapStringString(t *testing.T) {
 	t.Parallel()
 
@@ -818,6 +911,50 @@ func TestMapValueType(t *testing.T) {
 	}
 }
 
+var _ xattr.TypeWithValidateKey = lowercaseKeyStringType{}
+
+// lowercaseKeyStringType is a map element type which rejects map keys
+// containing uppercase letters.
+type lowercaseKeyStringType struct {
+	StringType
+}
+
+func (t lowercaseKeyStringType) ValidateKey(_ context.Context, key string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if strings.ToLower(key) != key {
+		diags.AddAttributeError(
+			path,
+			"Invalid Map Key",
+			"Map keys must not contain uppercase letters, got: "+key,
+		)
+	}
+
+	return diags
+}
+
+var _ xattr.TypeWithValidateKey = lowercaseKeyMapType{}
+
+// lowercaseKeyMapType is a custom map type which rejects map keys containing
+// uppercase letters.
+type lowercaseKeyMapType struct {
+	MapType
+}
+
+func (t lowercaseKeyMapType) ValidateKey(_ context.Context, key string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if strings.ToLower(key) != key {
+		diags.AddAttributeError(
+			path,
+			"Invalid Map Key",
+			"Map keys must not contain uppercase letters, got: "+key,
+		)
+	}
+
+	return diags
+}
+
 func TestMapTypeValidate(t *testing.T) {
 	t.Parallel()
 
@@ -857,6 +994,210 @@ func TestMapTypeValidate(t *testing.T) {
 			}),
 			path: path.Root("test"),
 		},
+		"key-validation-empty": {
+			mapType: MapType{
+				ElemType: lowercaseKeyStringType{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{}),
+			path: path.Root("test"),
+		},
+		"key-validation-null": {
+			mapType: MapType{
+				ElemType: lowercaseKeyStringType{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, nil),
+			path: path.Root("test"),
+		},
+		"key-validation-valid": {
+			mapType: MapType{
+				ElemType: lowercaseKeyStringType{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			path: path.Root("test"),
+		},
+		"key-validation-invalid": {
+			mapType: MapType{
+				ElemType: lowercaseKeyStringType{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+				"TestKey": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
+		"nested-map-type-key-validation-invalid": {
+			mapType: MapType{
+				ElemType: lowercaseKeyMapType{
+					MapType: MapType{
+						ElemType: StringType{},
+					},
+				},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Map{
+					ElementType: tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"Outer": tftypes.NewValue(tftypes.Map{
+					ElementType: tftypes.String,
+				}, map[string]tftypes.Value{
+					"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+					"TestKey": tftypes.NewValue(tftypes.String, "testvalue"),
+				}),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("Outer").AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
+		"empty-key-disabled": {
+			mapType: MapType{
+				ElemType: StringType{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"":        tftypes.NewValue(tftypes.String, "testvalue"),
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			path: path.Root("test"),
+		},
+		"empty-key-enabled": {
+			mapType: MapType{
+				ElemType:          StringType{},
+				DisallowEmptyKeys: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"":        tftypes.NewValue(tftypes.String, "testvalue"),
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey(""),
+					"Empty Map Key",
+					"This attribute contains an empty map key, which is not permitted.",
+				),
+			},
+		},
+		"empty-key-enabled-no-empty-key": {
+			mapType: MapType{
+				ElemType:          StringType{},
+				DisallowEmptyKeys: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"testkey": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			path: path.Root("test"),
+		},
+		"empty-key-enabled-key-validation": {
+			mapType: MapType{
+				ElemType:          lowercaseKeyStringType{},
+				DisallowEmptyKeys: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, map[string]tftypes.Value{
+				"":        tftypes.NewValue(tftypes.String, "testvalue"),
+				"TestKey": tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey(""),
+					"Empty Map Key",
+					"This attribute contains an empty map key, which is not permitted.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("TestKey"),
+					"Invalid Map Key",
+					"Map keys must not contain uppercase letters, got: TestKey",
+				),
+			},
+		},
+		"empty-key-enabled-null": {
+			mapType: MapType{
+				ElemType:          StringType{},
+				DisallowEmptyKeys: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, nil),
+			path: path.Root("test"),
+		},
+		"empty-key-enabled-unknown": {
+			mapType: MapType{
+				ElemType:          StringType{},
+				DisallowEmptyKeys: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.String,
+			}, tftypes.UnknownValue),
+			path: path.Root("test"),
+		},
+		"numeric-element-coercion": {
+			mapType: MapType{
+				ElemType:               Float64Type{},
+				NumericElementCoercion: true,
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Number,
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Number, int64(1)),
+				"b": tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			path: path.Root("test"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtMapKey("a"),
+					"Numeric Element Coerced",
+					"The integer element value 1 was converted to a 64-bit floating point number.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("b"),
+					"Lossy Numeric Element Coercion",
+					"This attribute contains an element which cannot be converted to the element type without loss: "+
+						"Value 9007199254740993 cannot be represented exactly as a 64-bit floating point.",
+				),
+			},
+		},
+		"without-numeric-element-coercion": {
+			mapType: MapType{
+				ElemType: Float64Type{},
+			},
+			tfValue: tftypes.NewValue(tftypes.Map{
+				ElementType: tftypes.Number,
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Number, int64(1)),
+				"b": tftypes.NewValue(tftypes.Number, int64(9007199254740993)),
+			}),
+			path: path.Root("test"),
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -873,3 +1214,1094 @@ func TestMapTypeValidate(t *testing.T) {
 		})
 	}
 }
+
+var _ xattr.TypeWithValidate = invalidValueStringType{}
+
+// invalidValueStringType is a string type which returns an error diagnostic
+// for the "invalid" value.
+type invalidValueStringType struct {
+	StringType
+}
+
+func (t invalidValueStringType) Validate(_ context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var s string
+
+	if err := in.As(&s); err != nil {
+		diags.AddAttributeError(path, "Unexpected Error", err.Error())
+
+		return diags
+	}
+
+	if s == "invalid" {
+		diags.AddAttributeError(path, "Invalid Value", "The value is invalid.")
+	}
+
+	return diags
+}
+
+func TestMapTypeValidate_NestedListPath(t *testing.T) {
+	t.Parallel()
+
+	mapType := MapType{
+		ElemType: ListType{
+			ElemType: invalidValueStringType{},
+		},
+	}
+
+	listValue := func(values ...string) tftypes.Value {
+		elements := make([]tftypes.Value, 0, len(values))
+
+		for _, value := range values {
+			elements = append(elements, tftypes.NewValue(tftypes.String, value))
+		}
+
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+	}
+
+	tfValue := tftypes.NewValue(
+		tftypes.Map{ElementType: tftypes.List{ElementType: tftypes.String}},
+		map[string]tftypes.Value{
+			"j": listValue("valid", "invalid"),
+			"k": listValue("valid", "valid", "valid", "invalid"),
+		},
+	)
+
+	diags := mapType.Validate(context.Background(), tfValue, path.Root("test"))
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(path.Root("test").AtMapKey("j").AtListIndex(1), "Invalid Value", "The value is invalid."),
+		diag.NewAttributeErrorDiagnostic(path.Root("test").AtMapKey("k").AtListIndex(3), "Invalid Value", "The value is invalid."),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Fatalf("unexpected diagnostics difference: %s", diff)
+	}
+
+	expectedPaths := []string{`test["j"][1]`, `test["k"][3]`}
+
+	for index, expectedPath := range expectedPaths {
+		diagWithPath, ok := diags[index].(diag.DiagnosticWithPath)
+
+		if !ok {
+			t.Fatalf("expected diagnostic with path, got: %T", diags[index])
+		}
+
+		if got := diagWithPath.Path().String(); got != expectedPath {
+			t.Errorf("expected path %s, got: %s", expectedPath, got)
+		}
+	}
+}
+
+func TestMapTypeValidate_MaxElementDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	mapType := MapType{
+		ElemType:              lowercaseKeyStringType{},
+		MaxElementDiagnostics: 2,
+	}
+
+	testCases := map[string]struct {
+		keys                []string
+		expectedDiagsCount  int
+		expectedLastSummary string
+	}{
+		"under-limit": {
+			keys:                []string{"KeyA", "keyb"},
+			expectedDiagsCount:  1,
+			expectedLastSummary: "Invalid Map Key",
+		},
+		"at-limit": {
+			keys:                []string{"KeyA", "KeyB"},
+			expectedDiagsCount:  2,
+			expectedLastSummary: "Invalid Map Key",
+		},
+		"over-limit": {
+			keys:                []string{"KeyA", "KeyB", "KeyC"},
+			expectedDiagsCount:  3,
+			expectedLastSummary: "Too Many Element Diagnostics",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			elems := make(map[string]tftypes.Value, len(testCase.keys))
+
+			for _, key := range testCase.keys {
+				elems[key] = tftypes.NewValue(tftypes.String, "testvalue")
+			}
+
+			tfValue := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems)
+
+			diags := mapType.Validate(context.Background(), tfValue, path.Root("test"))
+
+			if len(diags) != testCase.expectedDiagsCount {
+				t.Fatalf("expected %d diagnostics, got: %v", testCase.expectedDiagsCount, diags)
+			}
+
+			if got := diags[len(diags)-1].Summary(); got != testCase.expectedLastSummary {
+				t.Errorf("expected last diagnostic summary %q, got: %q", testCase.expectedLastSummary, got)
+			}
+		})
+	}
+}
+
+func TestMapValueConvertValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          MapValue
+		targetElemType attr.Type
+		expected       MapValue
+		expectedDiags  diag.Diagnostics
+	}{
+		"string-to-custom-string": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringNull(),
+					"c": NewStringUnknown(),
+				},
+			),
+			targetElemType: customStringType{},
+			expected: NewMapValueMust(
+				customStringType{},
+				map[string]attr.Value{
+					"a": customStringValue{StringValue: NewStringValue("one")},
+					"b": customStringValue{StringValue: NewStringNull()},
+					"c": customStringValue{StringValue: NewStringUnknown()},
+				},
+			),
+		},
+		"empty": {
+			input:          NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			targetElemType: customStringType{},
+			expected:       NewMapValueMust(customStringType{}, map[string]attr.Value{}),
+		},
+		"null": {
+			input:          NewMapNull(StringType{}),
+			targetElemType: customStringType{},
+			expected:       NewMapNull(customStringType{}),
+		},
+		"unknown": {
+			input:          NewMapUnknown(StringType{}),
+			targetElemType: customStringType{},
+			expected:       NewMapUnknown(customStringType{}),
+		},
+		"incompatible-element-type": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			targetElemType: BoolType{},
+			expected:       NewMapUnknown(BoolType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Element Conversion Error",
+					"An unexpected error was encountered trying to convert a map element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Map Key (a) could not be converted to basetypes.BoolType: can't unmarshal tftypes.String into *bool, expected boolean",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.ConvertValues(context.Background(), testCase.targetElemType)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueWithStateRedactedPaths(t *testing.T) {
+	t.Parallel()
+
+	value := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"token": NewStringValue("secret"),
+		},
+	).WithStateRedactedPaths(path.Empty())
+
+	got, err := value.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, "secret"),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected configuration difference: %s", diff)
+	}
+
+	got, err = value.ToTerraformValue(fwcontext.WithStateSerialization(context.Background()))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"token": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
+
+func TestMapValueMergeStateRedactedPaths(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"password": StringType{},
+		"username": StringType{},
+	}
+	objectTfType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"password": tftypes.String,
+			"username": tftypes.String,
+		},
+	}
+	element := NewObjectValueMust(
+		attributeTypes,
+		map[string]attr.Value{
+			"password": NewStringValue("secret"),
+			"username": NewStringValue("admin"),
+		},
+	)
+
+	value := NewMapValueMust(
+		ObjectType{AttrTypes: attributeTypes},
+		map[string]attr.Value{
+			"first": element,
+		},
+	).WithStateRedactedPaths(path.Root("password"))
+	other := NewMapValueMust(
+		ObjectType{AttrTypes: attributeTypes},
+		map[string]attr.Value{
+			"second": element,
+		},
+	).WithStateRedactedPaths(path.Root("username"))
+
+	merged, diags := value.Merge(context.Background(), other, ConflictPolicyError)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	got, err := merged.ToTerraformValue(fwcontext.WithStateSerialization(context.Background()))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	redacted := tftypes.NewValue(objectTfType, map[string]tftypes.Value{
+		"password": tftypes.NewValue(tftypes.String, nil),
+		"username": tftypes.NewValue(tftypes.String, nil),
+	})
+	expected := tftypes.NewValue(tftypes.Map{ElementType: objectTfType}, map[string]tftypes.Value{
+		"first":  redacted,
+		"second": redacted,
+	})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected state difference: %s", diff)
+	}
+}
+
+func TestMapValueEqualTreatingMissingAsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		other    MapValue
+		expected bool
+	}{
+		"missing-vs-null": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringNull(),
+				},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			expected: true,
+		},
+		"null-vs-missing": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringNull(),
+				},
+			),
+			expected: true,
+		},
+		"missing-vs-value": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringValue("two"),
+				},
+			),
+			expected: false,
+		},
+		"missing-vs-unknown": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"b": NewStringUnknown(),
+				},
+			),
+			expected: false,
+		},
+		"present-vs-present": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringNull(),
+				},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringNull(),
+				},
+			),
+			expected: true,
+		},
+		"differing-values": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("two"),
+				},
+			),
+			expected: false,
+		},
+		"differing-element-types": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			other:    NewMapValueMust(BoolType{}, map[string]attr.Value{}),
+			expected: false,
+		},
+		"null-vs-empty": {
+			input:    NewMapNull(StringType{}),
+			other:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: false,
+		},
+		"null-vs-null": {
+			input:    NewMapNull(StringType{}),
+			other:    NewMapNull(StringType{}),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.EqualTreatingMissingAsNull(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestMapValueDiff(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input           MapValue
+		prior           MapValue
+		expectedAdded   map[string]attr.Value
+		expectedRemoved map[string]attr.Value
+		expectedChanged map[string][2]attr.Value
+		expectedDiags   diag.Diagnostics
+	}{
+		"added-removed-changed": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"added":     NewStringValue("new"),
+					"changed":   NewStringValue("after"),
+					"unchanged": NewStringValue("same"),
+				},
+			),
+			prior: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"changed":   NewStringValue("before"),
+					"removed":   NewStringValue("old"),
+					"unchanged": NewStringValue("same"),
+				},
+			),
+			expectedAdded: map[string]attr.Value{
+				"added": NewStringValue("new"),
+			},
+			expectedRemoved: map[string]attr.Value{
+				"removed": NewStringValue("old"),
+			},
+			expectedChanged: map[string][2]attr.Value{
+				"changed": {NewStringValue("before"), NewStringValue("after")},
+			},
+		},
+		"equal": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringValue("value"),
+				},
+			),
+			prior: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringValue("value"),
+				},
+			),
+			expectedAdded:   map[string]attr.Value{},
+			expectedRemoved: map[string]attr.Value{},
+			expectedChanged: map[string][2]attr.Value{},
+		},
+		"null-prior": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringValue("value"),
+				},
+			),
+			prior: NewMapNull(StringType{}),
+			expectedAdded: map[string]attr.Value{
+				"key": NewStringValue("value"),
+			},
+			expectedRemoved: map[string]attr.Value{},
+			expectedChanged: map[string][2]attr.Value{},
+		},
+		"null-to-value-element": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringValue("value"),
+				},
+			),
+			prior: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"key": NewStringNull(),
+				},
+			),
+			expectedAdded:   map[string]attr.Value{},
+			expectedRemoved: map[string]attr.Value{},
+			expectedChanged: map[string][2]attr.Value{
+				"key": {NewStringNull(), NewStringValue("value")},
+			},
+		},
+		"unknown": {
+			input: NewMapUnknown(StringType{}),
+			prior: NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Diff Error",
+					"An unexpected error was encountered trying to compare maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unknown maps cannot be compared.",
+				),
+			},
+		},
+		"mismatched-element-types": {
+			input: NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			prior: NewMapValueMust(BoolType{}, map[string]attr.Value{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Diff Error",
+					"An unexpected error was encountered trying to compare maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Prior Map Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			added, removed, changed, diags := testCase.input.Diff(testCase.prior)
+
+			if diff := cmp.Diff(added, testCase.expectedAdded); diff != "" {
+				t.Errorf("unexpected added difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(removed, testCase.expectedRemoved); diff != "" {
+				t.Errorf("unexpected removed difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(changed, testCase.expectedChanged); diff != "" {
+				t.Errorf("unexpected changed difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueElementsSortedKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    MapValue
+		expected []string
+	}{
+		"unicode-keys": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"zebra": NewStringValue("1"),
+					"émile": NewStringValue("2"),
+					"Zebra": NewStringValue("3"),
+					"日本":    NewStringValue("4"),
+					"apple": NewStringValue("5"),
+				},
+			),
+			expected: []string{"Zebra", "apple", "zebra", "émile", "日本"},
+		},
+		"empty": {
+			input:    NewMapValueMust(StringType{}, map[string]attr.Value{}),
+			expected: []string{},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: []string{},
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: []string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementsSortedKeys()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueRangeSorted(t *testing.T) {
+	t.Parallel()
+
+	input := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"c": NewStringValue("3"),
+			"a": NewStringValue("1"),
+			"b": NewStringValue("2"),
+		},
+	)
+
+	testCases := map[string]struct {
+		input        MapValue
+		stopAfterKey string
+		expected     []string
+	}{
+		"all": {
+			input:    input,
+			expected: []string{"a=\"1\"", "b=\"2\"", "c=\"3\""},
+		},
+		"stop": {
+			input:        input,
+			stopAfterKey: "b",
+			expected:     []string{"a=\"1\"", "b=\"2\""},
+		},
+		"null": {
+			input: NewMapNull(StringType{}),
+		},
+		"unknown": {
+			input: NewMapUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			testCase.input.RangeSorted(func(key string, val attr.Value) bool {
+				got = append(got, key+"="+val.String())
+
+				return key != testCase.stopAfterKey
+			})
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueStringMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         MapValue
+		expected      map[string]string
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringValue("two"),
+				},
+			),
+			expected: map[string]string{
+				"a": "one",
+				"b": "two",
+			},
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Value",
+					"An unexpected error was encountered trying to convert map elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The map is unknown.",
+				),
+			},
+		},
+		"unknown-element": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"a": NewStringUnknown(),
+				},
+			),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Value",
+					"An unexpected error was encountered trying to convert collection elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected map element \"a\" to be known and not null, got: <unknown>. Use ElementsAs with a target type that handles null or unknown values.",
+				),
+			},
+		},
+		"wrong-element-type": {
+			input:    NewMapValueMust(BoolType{}, map[string]attr.Value{"a": NewBoolValue(true)}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Type",
+					"An unexpected error was encountered trying to convert map elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected element type basetypes.StringType, got: basetypes.BoolType. Use ElementsAs for other element types.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.StringMap(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueMerge(t *testing.T) {
+	t.Parallel()
+
+	defaultTags := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"environment": NewStringValue("default"),
+			"owner":       NewStringValue("team"),
+		},
+	)
+	userTags := NewMapValueMust(
+		StringType{},
+		map[string]attr.Value{
+			"environment": NewStringValue("production"),
+			"name":        NewStringValue("example"),
+			"owner":       NewStringValue("team"),
+		},
+	)
+
+	testCases := map[string]struct {
+		receiver      MapValue
+		other         MapValue
+		onConflict    ConflictPolicy
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"prefer-receiver": {
+			receiver:   defaultTags,
+			other:      userTags,
+			onConflict: ConflictPolicyPreferReceiver,
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"environment": NewStringValue("default"),
+					"name":        NewStringValue("example"),
+					"owner":       NewStringValue("team"),
+				},
+			),
+		},
+		"prefer-other": {
+			receiver:   defaultTags,
+			other:      userTags,
+			onConflict: ConflictPolicyPreferOther,
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"environment": NewStringValue("production"),
+					"name":        NewStringValue("example"),
+					"owner":       NewStringValue("team"),
+				},
+			),
+		},
+		"error": {
+			receiver:   defaultTags,
+			other:      userTags,
+			onConflict: ConflictPolicyError,
+			expected:   NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Merge Conflict",
+					"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Map Key (environment) has conflicting elements: "default" and "production"`,
+				),
+			},
+		},
+		"error-no-conflicts": {
+			receiver: defaultTags,
+			other: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"name":  NewStringValue("example"),
+					"owner": NewStringValue("team"),
+				},
+			),
+			onConflict: ConflictPolicyError,
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"environment": NewStringValue("default"),
+					"name":        NewStringValue("example"),
+					"owner":       NewStringValue("team"),
+				},
+			),
+		},
+		"receiver-null": {
+			receiver:   NewMapNull(StringType{}),
+			other:      userTags,
+			onConflict: ConflictPolicyError,
+			expected:   userTags,
+		},
+		"other-null": {
+			receiver:   defaultTags,
+			other:      NewMapNull(StringType{}),
+			onConflict: ConflictPolicyError,
+			expected:   defaultTags,
+		},
+		"both-null": {
+			receiver:   NewMapNull(StringType{}),
+			other:      NewMapNull(StringType{}),
+			onConflict: ConflictPolicyError,
+			expected:   NewMapNull(StringType{}),
+		},
+		"receiver-unknown": {
+			receiver:   NewMapUnknown(StringType{}),
+			other:      userTags,
+			onConflict: ConflictPolicyPreferReceiver,
+			expected:   NewMapUnknown(StringType{}),
+		},
+		"other-unknown": {
+			receiver:   defaultTags,
+			other:      NewMapUnknown(StringType{}),
+			onConflict: ConflictPolicyPreferReceiver,
+			expected:   NewMapUnknown(StringType{}),
+		},
+		"mismatched-element-types": {
+			receiver:   defaultTags,
+			other:      NewMapValueMust(BoolType{}, map[string]attr.Value{"enabled": NewBoolValue(true)}),
+			onConflict: ConflictPolicyPreferReceiver,
+			expected:   NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Merge Error",
+					"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Map Element Type: basetypes.StringType\n"+
+						"Other Map Element Type: basetypes.BoolType",
+				),
+			},
+		},
+		"invalid-policy": {
+			receiver:   defaultTags,
+			other:      userTags,
+			onConflict: ConflictPolicy(100),
+			expected:   NewMapUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Map Merge Error",
+					"An unexpected error was encountered trying to merge maps. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Unhandled ConflictPolicy: 100",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.receiver.Merge(context.Background(), testCase.other, testCase.onConflict)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueFilterKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         MapValue
+		keys          []string
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"subset": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env":        NewStringValue("prod"),
+					"managed-by": NewStringValue("system"),
+					"team":       NewStringValue("core"),
+				},
+			),
+			keys: []string{"env", "team", "missing"},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env":  NewStringValue("prod"),
+					"team": NewStringValue("core"),
+				},
+			),
+		},
+		"zero-keys": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env": NewStringValue("prod"),
+				},
+			),
+			keys:     nil,
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{}),
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			keys:     []string{"env"},
+			expected: NewMapNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			keys:     []string{"env"},
+			expected: NewMapUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.FilterKeys(testCase.keys)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMapValueWithoutKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         MapValue
+		keys          []string
+		expected      MapValue
+		expectedDiags diag.Diagnostics
+	}{
+		"drop": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env":        NewStringValue("prod"),
+					"managed-by": NewStringValue("system"),
+				},
+			),
+			keys: []string{"managed-by"},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env": NewStringValue("prod"),
+				},
+			),
+		},
+		"drop-all": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env": NewStringValue("prod"),
+				},
+			),
+			keys:     []string{"env"},
+			expected: NewMapValueMust(StringType{}, map[string]attr.Value{}),
+		},
+		"non-existent-key": {
+			input: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env": NewStringValue("prod"),
+				},
+			),
+			keys: []string{"missing"},
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"env": NewStringValue("prod"),
+				},
+			),
+		},
+		"null": {
+			input:    NewMapNull(StringType{}),
+			keys:     []string{"env"},
+			expected: NewMapNull(StringType{}),
+		},
+		"unknown": {
+			input:    NewMapUnknown(StringType{}),
+			keys:     []string{"env"},
+			expected: NewMapUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.WithoutKeys(testCase.keys)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got.IsNull() != testCase.expected.IsNull() {
+				t.Errorf("expected null %t, got %t", testCase.expected.IsNull(), got.IsNull())
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// coercedFloat64ValueFromBigFloat returns a Float64Value for the given
+// number, as with float64ValueFromBigFloat, unless the number is an integer
+// which a 64-bit floating point cannot represent exactly, such as 2^53+1, in
+// which case an error is returned.
+func coercedFloat64ValueFromBigFloat(bigF *big.Float) (attr.Value, error) {
+	if bigF.IsInt() {
+		if _, accuracy := bigF.Float64(); accuracy != big.Exact {
+			return nil, fmt.Errorf("Value %s cannot be represented exactly as a 64-bit floating point.", bigF.Text('f', -1))
+		}
+	}
+
+	return float64ValueFromBigFloat(bigF)
+}
+
+// numericElementCoercionDiagnostics returns the diagnostics of converting the
+// given element with numeric element coercion, at the given element path. An
+// error diagnostic is returned if the element cannot be converted into the
+// element type without loss, while a warning diagnostic is returned for an
+// integer widened into a Float64Type element. No diagnostics are returned
+// for element types other than Int64Type and Float64Type, or for null and
+// unknown elements.
+func numericElementCoercionDiagnostics(elemType attr.Type, elem tftypes.Value, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var convert func(*big.Float) (attr.Value, error)
+
+	switch elemType.(type) {
+	case Int64Type:
+		convert = int64ValueFromBigFloat
+	case Float64Type:
+		convert = coercedFloat64ValueFromBigFloat
+	default:
+		return diags
+	}
+
+	if !elem.IsKnown() || elem.IsNull() {
+		return diags
+	}
+
+	bigF := new(big.Float)
+
+	if err := elem.As(bigF); err != nil {
+		diags.AddAttributeError(
+			p,
+			"Numeric Element Coercion Error",
+			"An unexpected error was encountered trying to convert a numeric element. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	if _, err := convert(bigF); err != nil {
+		diags.AddAttributeError(
+			p,
+			"Lossy Numeric Element Coercion",
+			"This attribute contains an element which cannot be converted to the element type without loss: "+err.Error(),
+		)
+
+		return diags
+	}
+
+	if _, ok := elemType.(Float64Type); ok && bigF.IsInt() {
+		diags.AddAttributeWarning(
+			p,
+			"Numeric Element Coerced",
+			fmt.Sprintf("The integer element value %s was converted to a 64-bit floating point number.", bigF.Text('f', -1)),
+		)
+	}
+
+	return diags
+}
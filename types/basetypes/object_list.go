@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ObjectListElementBuilder incrementally constructs a List of objects. Create
+// one with the ObjectListBuilder function.
+type ObjectListElementBuilder struct {
+	// diags contains the accumulated diagnostics of all added objects.
+	diags diag.Diagnostics
+
+	// elements contains the successfully created objects.
+	elements []attr.Value
+
+	// objectType is the element type of the List.
+	objectType ObjectType
+}
+
+// ObjectListBuilder returns a builder for a List with the given object element
+// type. Add elements via the AddObject method and create the List via the
+// Build method.
+func ObjectListBuilder(objectType ObjectType) *ObjectListElementBuilder {
+	return &ObjectListElementBuilder{
+		elements:   []attr.Value{},
+		objectType: objectType,
+	}
+}
+
+// AddObject creates an object with the given attribute values and adds it to
+// the end of the List. Any diagnostics from creating the object, such as
+// missing, extra, or mismatched attribute types, are returned by Build.
+func (b *ObjectListElementBuilder) AddObject(attributes map[string]attr.Value) *ObjectListElementBuilder {
+	object, diags := NewObjectValue(b.objectType.AttrTypes, attributes)
+
+	b.diags.Append(diags...)
+
+	if !diags.HasError() {
+		b.elements = append(b.elements, object)
+	}
+
+	return b
+}
+
+// Build returns the List containing all added objects. If any added object
+// returned error diagnostics, an unknown List is returned with all
+// accumulated diagnostics.
+func (b *ObjectListElementBuilder) Build() (ListValue, diag.Diagnostics) {
+	if b.diags.HasError() {
+		return NewListUnknown(b.objectType), b.diags
+	}
+
+	list, diags := NewListValue(b.objectType, b.elements)
+
+	b.diags.Append(diags...)
+
+	return list, b.diags
+}
+
+// ObjectListEqualWithTolerance returns true if both lists have the same
+// element type, value state, and contain equal object elements in the same
+// order. Known numeric attributes of each object element whose names appear in
+// `tolerances` are considered equal if their absolute difference is less than
+// or equal to the given tolerance, all other attributes are compared with the
+// Equal method of the attribute value.
+//
+// This is intended for suppressing differences caused by floating point
+// imprecision in remote systems. Elements which are not objects are compared
+// with their Equal method.
+func ObjectListEqualWithTolerance(a, b ListValue, tolerances map[string]float64) bool {
+	if a.elementType == nil || !a.elementType.Equal(b.elementType) {
+		return false
+	}
+
+	if a.state != b.state {
+		return false
+	}
+
+	if a.state != attr.ValueStateKnown {
+		return true
+	}
+
+	if len(a.elements) != len(b.elements) {
+		return false
+	}
+
+	for idx, aElem := range a.elements {
+		if !objectEqualWithTolerance(aElem, b.elements[idx], tolerances) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// objectEqualWithTolerance compares two object values, allowing the named
+// numeric attributes to differ within the given tolerances.
+func objectEqualWithTolerance(a, b attr.Value, tolerances map[string]float64) bool {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	aValuable, aOk := a.(ObjectValuable)
+	bValuable, bOk := b.(ObjectValuable)
+
+	if !aOk || !bOk {
+		return a.Equal(b)
+	}
+
+	aObject, diags := aValuable.ToObjectValue(ctx)
+
+	if diags.HasError() {
+		return false
+	}
+
+	bObject, diags := bValuable.ToObjectValue(ctx)
+
+	if diags.HasError() {
+		return false
+	}
+
+	if aObject.state != bObject.state || !aObject.Type(ctx).Equal(bObject.Type(ctx)) {
+		return false
+	}
+
+	if aObject.state != attr.ValueStateKnown {
+		return true
+	}
+
+	for name, aAttribute := range aObject.attributes {
+		bAttribute, ok := bObject.attributes[name]
+
+		if !ok {
+			return false
+		}
+
+		tolerance, ok := tolerances[name]
+
+		if !ok {
+			if !aAttribute.Equal(bAttribute) {
+				return false
+			}
+
+			continue
+		}
+
+		aNumber, aOk := numericBigFloat(ctx, aAttribute)
+		bNumber, bOk := numericBigFloat(ctx, bAttribute)
+
+		if !aOk || !bOk {
+			if !aAttribute.Equal(bAttribute) {
+				return false
+			}
+
+			continue
+		}
+
+		difference := new(big.Float).Sub(aNumber, bNumber)
+
+		if difference.Abs(difference).Cmp(big.NewFloat(tolerance)) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// numericBigFloat returns the *big.Float representation of a known Float64,
+// Int64, or Number value and true, otherwise false.
+func numericBigFloat(ctx context.Context, v attr.Value) (*big.Float, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return nil, false
+	}
+
+	switch value := v.(type) {
+	case Float64Valuable:
+		float64Value, diags := value.ToFloat64Value(ctx)
+
+		if diags.HasError() || float64Value.IsNull() || float64Value.IsUnknown() {
+			return nil, false
+		}
+
+		return big.NewFloat(float64Value.ValueFloat64()), true
+	case Int64Valuable:
+		int64Value, diags := value.ToInt64Value(ctx)
+
+		if diags.HasError() || int64Value.IsNull() || int64Value.IsUnknown() {
+			return nil, false
+		}
+
+		return new(big.Float).SetInt64(int64Value.ValueInt64()), true
+	case NumberValuable:
+		numberValue, diags := value.ToNumberValue(ctx)
+
+		if diags.HasError() || numberValue.IsNull() || numberValue.IsUnknown() {
+			return nil, false
+		}
+
+		return numberValue.ValueBigFloat(), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestObjectListEqualWithTolerance(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name":   StringType{},
+		"weight": Float64Type{},
+	}
+	objectType := ObjectType{AttrTypes: attributeTypes}
+
+	newList := func(name string, weight float64) ListValue {
+		return NewListValueMust(
+			objectType,
+			[]attr.Value{
+				NewObjectValueMust(
+					attributeTypes,
+					map[string]attr.Value{
+						"name":   NewStringValue(name),
+						"weight": NewFloat64Value(weight),
+					},
+				),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		a          ListValue
+		b          ListValue
+		tolerances map[string]float64
+		expected   bool
+	}{
+		"equal": {
+			a:          newList("test", 1.5),
+			b:          newList("test", 1.5),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   true,
+		},
+		"within-tolerance": {
+			a:          newList("test", 1.5),
+			b:          newList("test", 1.505),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   true,
+		},
+		"out-of-tolerance": {
+			a:          newList("test", 1.5),
+			b:          newList("test", 1.6),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   false,
+		},
+		"no-tolerance": {
+			a:        newList("test", 1.5),
+			b:        newList("test", 1.505),
+			expected: false,
+		},
+		"non-tolerance-attribute-difference": {
+			a:          newList("test", 1.5),
+			b:          newList("other", 1.5),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   false,
+		},
+		"null-attribute": {
+			a: newList("test", 1.5),
+			b: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					NewObjectValueMust(
+						attributeTypes,
+						map[string]attr.Value{
+							"name":   NewStringValue("test"),
+							"weight": NewFloat64Null(),
+						},
+					),
+				},
+			),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   false,
+		},
+		"different-length": {
+			a:          newList("test", 1.5),
+			b:          NewListValueMust(objectType, []attr.Value{}),
+			tolerances: map[string]float64{"weight": 0.01},
+			expected:   false,
+		},
+		"null": {
+			a:        NewListNull(objectType),
+			b:        NewListNull(objectType),
+			expected: true,
+		},
+		"null-known": {
+			a:        NewListNull(objectType),
+			b:        newList("test", 1.5),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ObjectListEqualWithTolerance(testCase.a, testCase.b, testCase.tolerances)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestObjectListBuilder(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name": StringType{},
+	}
+	objectType := ObjectType{AttrTypes: attributeTypes}
+
+	testCases := map[string]struct {
+		objects       []map[string]attr.Value
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"no-objects": {
+			expected: NewListValueMust(objectType, []attr.Value{}),
+		},
+		"valid": {
+			objects: []map[string]attr.Value{
+				{"name": NewStringValue("first")},
+				{"name": NewStringNull()},
+			},
+			expected: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					NewObjectValueMust(attributeTypes, map[string]attr.Value{"name": NewStringValue("first")}),
+					NewObjectValueMust(attributeTypes, map[string]attr.Value{"name": NewStringNull()}),
+				},
+			),
+		},
+		"invalid": {
+			objects: []map[string]attr.Value{
+				{"name": NewStringValue("first")},
+				{"name": NewBoolValue(true)},
+				{},
+			},
+			expected: NewListUnknown(objectType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"While creating a Object value, an invalid attribute value was detected. "+
+						"A Object must use a matching attribute type for the value. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (name) Expected Type: basetypes.StringType\n"+
+						"Object Attribute Name (name) Given Type: basetypes.BoolType",
+				),
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute Value",
+					"While creating a Object value, a missing attribute value was detected. "+
+						"A Object must contain values for all attributes, even if null or unknown. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (name) Expected Type: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := ObjectListBuilder(objectType)
+
+			for _, object := range testCase.objects {
+				builder.AddObject(object)
+			}
+
+			got, diags := builder.Build()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
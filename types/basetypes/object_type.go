@@ -10,11 +10,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-var _ ObjectTypable = ObjectType{}
+// logKeyAttributeName is the structured logging key for an object attribute
+// name which was ignored during conversion.
+const logKeyAttributeName = "tf_object_attribute_name"
+
+var (
+	_ ObjectTypable          = ObjectType{}
+	_ xattr.TypeWithValidate = ObjectType{}
+)
 
 // ObjectTypable extends attr.Type for object types.
 // Implement this interface to create a custom ObjectType type.
@@ -28,13 +38,47 @@ type ObjectTypable interface {
 // ObjectType is an AttributeType representing an object.
 type ObjectType struct {
 	AttrTypes map[string]attr.Type
+
+	// RequiredTogether, when set, causes Validate to return an error
+	// diagnostic if any attribute name in a group has a non-null value while
+	// others in the same group are null. Groups containing an unknown
+	// attribute value are not checked. By default, attributes are
+	// independent.
+	RequiredTogether [][]string
+
+	// IgnoreUndefinedAttributes, when enabled, causes ValueFromTerraform to
+	// ignore attributes present in the given value which are not defined in
+	// AttrTypes, rather than returning an error. This is intended for
+	// reading prior state during a state upgrade, where a schema may have
+	// removed attributes. Ignored attribute names are logged. All
+	// attributes in AttrTypes must still be present in the value. This also
+	// applies when the object type is nested in the base ListType, SetType,
+	// MapType, or ObjectType, whose ValueFromTerraform accepts the
+	// additional attributes of their nested values.
+	IgnoreUndefinedAttributes bool
 }
 
 // WithAttributeTypes returns a new copy of the type with its attribute types
 // set.
 func (o ObjectType) WithAttributeTypes(typs map[string]attr.Type) attr.TypeWithAttributeTypes {
 	return ObjectType{
-		AttrTypes: typs,
+		AttrTypes:                 typs,
+		RequiredTogether:          o.RequiredTogether,
+		IgnoreUndefinedAttributes: o.IgnoreUndefinedAttributes,
+	}
+}
+
+// WithAttributeType returns a new copy of the type with the attribute type
+// of `name` set to `typ`, adding the attribute if it does not exist. The
+// attribute types of the receiver are not modified.
+func (o ObjectType) WithAttributeType(name string, typ attr.Type) ObjectType {
+	attrTypes := o.AttributeTypes()
+	attrTypes[name] = typ
+
+	return ObjectType{
+		AttrTypes:                 attrTypes,
+		RequiredTogether:          o.RequiredTogether,
+		IgnoreUndefinedAttributes: o.IgnoreUndefinedAttributes,
 	}
 }
 
@@ -72,7 +116,7 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	if in.Type() == nil {
 		return NewObjectNull(o.AttrTypes), nil
 	}
-	if !in.Type().Equal(o.TerraformType(ctx)) {
+	if !o.acceptsTerraformType(ctx, in.Type()) {
 		return nil, fmt.Errorf("expected %s, got %s", o.TerraformType(ctx), in.Type())
 	}
 	if !in.IsKnown() {
@@ -90,6 +134,18 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	}
 
 	for k, v := range val {
+		if _, ok := o.AttrTypes[k]; !ok {
+			logging.FrameworkDebug(
+				ctx,
+				"Ignoring object attribute not defined in type",
+				map[string]interface{}{
+					logKeyAttributeName: k,
+				},
+			)
+
+			continue
+		}
+
 		a, err := o.AttrTypes[k].ValueFromTerraform(ctx, v)
 		if err != nil {
 			return nil, err
@@ -101,8 +157,59 @@ func (o ObjectType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 	return NewObjectValueMust(o.AttrTypes, attributes), nil
 }
 
+// acceptsTerraformType returns true if values of the given type can be
+// converted by ValueFromTerraform. This is an equal type, unless
+// IgnoreUndefinedAttributes is enabled, when it may also be an object type
+// with additional attributes. Attribute types are checked the same way, so
+// nested objects with IgnoreUndefinedAttributes enabled are also accepted.
+func (o ObjectType) acceptsTerraformType(ctx context.Context, typ tftypes.Type) bool {
+	objectType, ok := typ.(tftypes.Object)
+
+	if !ok || len(objectType.OptionalAttributes) > 0 {
+		return false
+	}
+
+	if !o.IgnoreUndefinedAttributes && len(objectType.AttributeTypes) != len(o.AttrTypes) {
+		return false
+	}
+
+	for name, attrType := range o.AttrTypes {
+		inAttrType, ok := objectType.AttributeTypes[name]
+
+		if !ok || !acceptsTerraformType(ctx, attrType, inAttrType) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// typeWithAcceptsTerraformType is implemented by the base collection and
+// object types, whose ValueFromTerraform may accept values of a Terraform
+// type which is not equal to their TerraformType, such as a list of objects
+// with IgnoreUndefinedAttributes enabled.
+type typeWithAcceptsTerraformType interface {
+	acceptsTerraformType(context.Context, tftypes.Type) bool
+}
+
+// acceptsTerraformType returns true if values of the given Terraform type can
+// be converted by the ValueFromTerraform method of `typ`. Types which do not
+// implement typeWithAcceptsTerraformType only accept their TerraformType.
+func acceptsTerraformType(ctx context.Context, typ attr.Type, tfType tftypes.Type) bool {
+	if typ == nil || tfType == nil {
+		return false
+	}
+
+	if typeWithAccepts, ok := typ.(typeWithAcceptsTerraformType); ok {
+		return typeWithAccepts.acceptsTerraformType(ctx, tfType)
+	}
+
+	return tfType.Equal(typ.TerraformType(ctx))
+}
+
 // Equal returns true if `candidate` is also an ObjectType and has the same
-// AttributeTypes.
+// AttributeTypes. Validation and conversion options, such as
+// RequiredTogether and IgnoreUndefinedAttributes, are not considered.
 func (o ObjectType) Equal(candidate attr.Type) bool {
 	other, ok := candidate.(ObjectType)
 	if !ok {
@@ -161,6 +268,131 @@ func (o ObjectType) String() string {
 	return res.String()
 }
 
+// Validate returns an error diagnostic for each RequiredTogether group of a
+// known object in which some, but not all, attributes are non-null.
+func (o ObjectType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || len(o.RequiredTogether) == 0 {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.Object{}) {
+		err := fmt.Errorf("expected Object value, received %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			"Object Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var attrs map[string]tftypes.Value
+
+	if err := in.As(&attrs); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Object Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	for _, group := range o.RequiredTogether {
+		diags.Append(validateRequiredTogether(path, attrs, group)...)
+	}
+
+	return diags
+}
+
+// validateRequiredTogether returns an error diagnostic if some, but not all,
+// of the attribute names in the group have non-null values. Missing
+// attributes are treated as null.
+func validateRequiredTogether(path path.Path, attrs map[string]tftypes.Value, group []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	names := make([]string, 0, len(group))
+	missing := make([]string, 0, len(group))
+
+	for _, name := range group {
+		value, ok := attrs[name]
+
+		if ok && !value.IsKnown() {
+			return diags
+		}
+
+		names = append(names, fmt.Sprintf("%q", name))
+
+		if !ok || value.IsNull() {
+			missing = append(missing, fmt.Sprintf("%q", name))
+		}
+	}
+
+	if len(missing) == 0 || len(missing) == len(names) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		path,
+		"Missing Required Together Attributes",
+		fmt.Sprintf("Attributes %s must be configured together. Missing: %s.", strings.Join(names, ", "), strings.Join(missing, ", ")),
+	)
+
+	return diags
+}
+
+// ValidatablePaths returns the paths, relative to the given value, of the
+// attributes whose types implement xattr.TypeWithValidate, in attribute name
+// order, without running validation. Paths within attributes are included
+// when the attribute type also implements ValidatablePaths, such as nested
+// collections. If the value is null or unknown, no paths are returned.
+func (o ObjectType) ValidatablePaths(ctx context.Context, in tftypes.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return nil, diags
+	}
+
+	var attrs map[string]tftypes.Value
+
+	if err := in.As(&attrs); err != nil {
+		diags.AddError(
+			"Object Type Validation Error",
+			"An unexpected error was encountered trying to determine the validatable paths of a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(o.AttrTypes))
+
+	for name := range o.AttrTypes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var paths path.Paths
+
+	for _, name := range names {
+		attrValue, ok := attrs[name]
+
+		if !ok {
+			continue
+		}
+
+		attrPaths, attrDiags := elementValidatablePaths(ctx, o.AttrTypes[name], attrValue, path.Root(name))
+
+		diags.Append(attrDiags...)
+		paths = append(paths, attrPaths...)
+	}
+
+	return paths, diags
+}
+
 // ValueType returns the Value type.
 func (o ObjectType) ValueType(_ context.Context) attr.Value {
 	return ObjectValue{
@@ -172,3 +404,85 @@ func (o ObjectType) ValueType(_ context.Context) attr.Value {
 func (o ObjectType) ValueFromObject(_ context.Context, obj ObjectValue) (ObjectValuable, diag.Diagnostics) {
 	return obj, nil
 }
+
+// CopyValue returns a deep copy of the given known Object value, with fresh
+// attribute storage. The copy is converted with the ValueFromObject method of
+// the value type, so custom types are preserved. Attributes are copied with
+// attr.Copy.
+func (o ObjectType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(ObjectValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	obj, diags := valuable.ToObjectValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to ObjectValue: %v", diags)
+	}
+
+	attributes, err := copyMapValues(ctx, obj.attributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	obj.attributes = attributes
+	obj.attributeTypes = obj.AttributeTypes(ctx)
+
+	typable, ok := v.Type(ctx).(ObjectTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromObject(ctx, obj)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting ObjectValue to ObjectValuable: %v", diags)
+	}
+
+	return result, nil
+}
+
+// NewValuePartial returns a known ObjectValue of the type with the given
+// attribute values, where any attributes of AttrTypes not given are set to a
+// null value of their attribute type. Given attributes must match the type
+// in AttrTypes and must not include attributes beyond AttrTypes, otherwise
+// error diagnostics are returned along with an unknown ObjectValue.
+func (o ObjectType) NewValuePartial(ctx context.Context, attrs map[string]attr.Value) (ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]attr.Value, len(o.AttrTypes))
+
+	for name, value := range attrs {
+		attributes[name] = value
+	}
+
+	for name, attrType := range o.AttrTypes {
+		if _, ok := attributes[name]; ok {
+			continue
+		}
+
+		nullValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+
+		if err != nil {
+			diags.AddError(
+				"Object Value Creation Error",
+				"An unexpected error was encountered trying to create a null attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Unable to create null value for object attribute %q: %s", name, err),
+			)
+
+			continue
+		}
+
+		attributes[name] = nullValue
+	}
+
+	if diags.HasError() {
+		return NewObjectUnknown(o.AttrTypes), diags
+	}
+
+	return NewObjectValue(o.AttrTypes, attributes)
+}
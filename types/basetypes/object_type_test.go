@@ -10,6 +10,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -24,6 +26,113 @@ func TestObjectTypeAttributeTypes_immutable(t *testing.T) {
 	}
 }
 
+func TestObjectTypeWithAttributeType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    ObjectType
+		name     string
+		typ      attr.Type
+		expected ObjectType
+	}{
+		"add": {
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+			name: "b",
+			typ:  BoolType{},
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": BoolType{},
+				},
+			},
+		},
+		"replace": {
+			input: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+			name: "a",
+			typ:  ListType{ElemType: StringType{}},
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": ListType{ElemType: StringType{}},
+				},
+			},
+		},
+		"nil-attribute-types": {
+			input: ObjectType{},
+			name:  "a",
+			typ:   StringType{},
+			expected: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			original := testCase.input.AttributeTypes()
+
+			got := testCase.input.WithAttributeType(testCase.name, testCase.typ)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s to equal %s", got, testCase.expected)
+			}
+
+			if got.Equal(testCase.input) {
+				t.Errorf("expected %s to not equal original %s", got, testCase.input)
+			}
+
+			if diff := cmp.Diff(testCase.input.AttributeTypes(), original); diff != "" {
+				t.Errorf("unexpected modification of original attribute types: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectTypeWithAttributeTypes(t *testing.T) {
+	t.Parallel()
+
+	input := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"a": StringType{},
+		},
+	}
+
+	got := input.WithAttributeTypes(map[string]attr.Type{
+		"b": BoolType{},
+	})
+
+	expected := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"b": BoolType{},
+		},
+	}
+
+	if !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+
+	if !input.Equal(ObjectType{AttrTypes: map[string]attr.Type{"a": StringType{}}}) {
+		t.Errorf("unexpected modification of original type: %s", input)
+	}
+}
+
 func TestObjectTypeTerraformType_simple(t *testing.T) {
 	t.Parallel()
 	result := ObjectType{
@@ -113,6 +222,196 @@ func TestObjectTypeValueFromTerraform(t *testing.T) {
 			}),
 			expectedErr: `expected tftypes.Object["a":tftypes.String], got tftypes.Object["a":tftypes.String, "b":tftypes.Bool]`,
 		},
+		"extra-attribute-ignore-undefined-attributes": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+				IgnoreUndefinedAttributes: true,
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+					"b": tftypes.Bool,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "red"),
+				"b": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"a": StringType{},
+				},
+				map[string]attr.Value{
+					"a": NewStringValue("red"),
+				},
+			),
+		},
+		"extra-attribute-ignore-undefined-attributes-unknown": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+				IgnoreUndefinedAttributes: true,
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+					"b": tftypes.Bool,
+				},
+			}, tftypes.UnknownValue),
+			expected: NewObjectUnknown(
+				map[string]attr.Type{
+					"a": StringType{},
+				},
+			),
+		},
+		"extra-attribute-ignore-undefined-attributes-mismatched-type": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+				},
+				IgnoreUndefinedAttributes: true,
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.Number,
+					"b": tftypes.Bool,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.Number, 123),
+				"b": tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expectedErr: `expected tftypes.Object["a":tftypes.String], got tftypes.Object["a":tftypes.Number, "b":tftypes.Bool]`,
+		},
+		"nested-extra-attribute-ignore-undefined-attributes": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"nested": ListType{
+						ElemType: ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"a": StringType{},
+							},
+							IgnoreUndefinedAttributes: true,
+						},
+					},
+				},
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"a": tftypes.String,
+								"b": tftypes.Bool,
+							},
+						},
+					},
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.List{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"a": tftypes.String,
+							"b": tftypes.Bool,
+						},
+					},
+				}, []tftypes.Value{
+					tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"a": tftypes.String,
+							"b": tftypes.Bool,
+						},
+					}, map[string]tftypes.Value{
+						"a": tftypes.NewValue(tftypes.String, "red"),
+						"b": tftypes.NewValue(tftypes.Bool, true),
+					}),
+				}),
+			}),
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"nested": ListType{
+						ElemType: ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"a": StringType{},
+							},
+						},
+					},
+				},
+				map[string]attr.Value{
+					"nested": NewListValueMust(
+						ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"a": StringType{},
+							},
+						},
+						[]attr.Value{
+							NewObjectValueMust(
+								map[string]attr.Type{
+									"a": StringType{},
+								},
+								map[string]attr.Value{
+									"a": NewStringValue("red"),
+								},
+							),
+						},
+					),
+				},
+			),
+		},
+		"nested-extra-attribute": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"nested": ListType{
+						ElemType: ObjectType{
+							AttrTypes: map[string]attr.Type{
+								"a": StringType{},
+							},
+						},
+					},
+				},
+				IgnoreUndefinedAttributes: true,
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": tftypes.List{
+						ElementType: tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"a": tftypes.String,
+								"b": tftypes.Bool,
+							},
+						},
+					},
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(tftypes.List{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"a": tftypes.String,
+							"b": tftypes.Bool,
+						},
+					},
+				}, nil),
+			}),
+			expectedErr: `expected tftypes.Object["nested":tftypes.List[tftypes.Object["a":tftypes.String]]], got tftypes.Object["nested":tftypes.List[tftypes.Object["a":tftypes.String, "b":tftypes.Bool]]]`,
+		},
+		"missing-attribute-ignore-undefined-attributes": {
+			receiver: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": BoolType{},
+				},
+				IgnoreUndefinedAttributes: true,
+			},
+			input: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"a": tftypes.String,
+				},
+			}, map[string]tftypes.Value{
+				"a": tftypes.NewValue(tftypes.String, "red"),
+			}),
+			expectedErr: `expected tftypes.Object["a":tftypes.String, "b":tftypes.Bool], got tftypes.Object["a":tftypes.String]`,
+		},
 		"missing-attribute": {
 			receiver: ObjectType{
 				AttrTypes: map[string]attr.Type{
@@ -377,3 +676,267 @@ func TestObjectTypeEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"credentials": StringType{},
+			"host":        StringType{},
+			"name":        StringType{},
+			"port":        NumberType{},
+		},
+		RequiredTogether: [][]string{
+			{"host", "port", "credentials"},
+		},
+	}
+
+	objectTerraformType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"credentials": tftypes.String,
+			"host":        tftypes.String,
+			"name":        tftypes.String,
+			"port":        tftypes.Number,
+		},
+	}
+
+	testCases := map[string]struct {
+		objectType    ObjectType
+		tfValue       tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"wrong-value-type": {
+			objectType: objectType,
+			tfValue:    tftypes.NewValue(tftypes.String, "testvalue"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Object Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"expected Object value, received tftypes.Value with value: tftypes.String<\"testvalue\">",
+				),
+			},
+		},
+		"no-groups": {
+			objectType: ObjectType{
+				AttrTypes: objectType.AttrTypes,
+			},
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, nil),
+				"host":        tftypes.NewValue(tftypes.String, "example.com"),
+				"name":        tftypes.NewValue(tftypes.String, nil),
+				"port":        tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+		"null": {
+			objectType: objectType,
+			tfValue:    tftypes.NewValue(objectTerraformType, nil),
+		},
+		"unknown": {
+			objectType: objectType,
+			tfValue:    tftypes.NewValue(objectTerraformType, tftypes.UnknownValue),
+		},
+		"group-satisfied": {
+			objectType: objectType,
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, "secret"),
+				"host":        tftypes.NewValue(tftypes.String, "example.com"),
+				"name":        tftypes.NewValue(tftypes.String, nil),
+				"port":        tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+			}),
+		},
+		"group-all-null": {
+			objectType: objectType,
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, nil),
+				"host":        tftypes.NewValue(tftypes.String, nil),
+				"name":        tftypes.NewValue(tftypes.String, "test"),
+				"port":        tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+		"group-partial": {
+			objectType: objectType,
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, nil),
+				"host":        tftypes.NewValue(tftypes.String, "example.com"),
+				"name":        tftypes.NewValue(tftypes.String, nil),
+				"port":        tftypes.NewValue(tftypes.Number, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Required Together Attributes",
+					`Attributes "host", "port", "credentials" must be configured together. Missing: "port", "credentials".`,
+				),
+			},
+		},
+		"group-partial-unknown": {
+			objectType: objectType,
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"host":        tftypes.NewValue(tftypes.String, "example.com"),
+				"name":        tftypes.NewValue(tftypes.String, nil),
+				"port":        tftypes.NewValue(tftypes.Number, nil),
+			}),
+		},
+		"multiple-groups": {
+			objectType: ObjectType{
+				AttrTypes: objectType.AttrTypes,
+				RequiredTogether: [][]string{
+					{"host", "port"},
+					{"name", "credentials"},
+				},
+			},
+			tfValue: tftypes.NewValue(objectTerraformType, map[string]tftypes.Value{
+				"credentials": tftypes.NewValue(tftypes.String, nil),
+				"host":        tftypes.NewValue(tftypes.String, nil),
+				"name":        tftypes.NewValue(tftypes.String, "test"),
+				"port":        tftypes.NewValue(tftypes.Number, big.NewFloat(443)),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Required Together Attributes",
+					`Attributes "host", "port" must be configured together. Missing: "host".`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Missing Required Together Attributes",
+					`Attributes "name", "credentials" must be configured together. Missing: "credentials".`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.objectType.Validate(context.Background(), testCase.tfValue, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectTypeNewValuePartial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		objectType    ObjectType
+		attrs         map[string]attr.Value
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}{
+		"partial": {
+			objectType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool":   BoolType{},
+					"list":   ListType{ElemType: StringType{}},
+					"string": StringType{},
+				},
+			},
+			attrs: map[string]attr.Value{
+				"string": NewStringValue("test"),
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"bool":   BoolType{},
+					"list":   ListType{ElemType: StringType{}},
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"bool":   NewBoolNull(),
+					"list":   NewListNull(StringType{}),
+					"string": NewStringValue("test"),
+				},
+			),
+		},
+		"empty": {
+			objectType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+				},
+			},
+			attrs: nil,
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"string": StringType{},
+				},
+				map[string]attr.Value{
+					"string": NewStringNull(),
+				},
+			),
+		},
+		"extra-attribute": {
+			objectType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"string": StringType{},
+				},
+			},
+			attrs: map[string]attr.Value{
+				"extra": NewStringValue("test"),
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"string": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Extra Object Attribute Value",
+					"While creating a Object value, an extra attribute value was detected. "+
+						"A Object must not contain values beyond the expected attribute types. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Extra Object Attribute Name: extra",
+				),
+			},
+		},
+		"type-mismatch": {
+			objectType: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"bool":   BoolType{},
+					"string": StringType{},
+				},
+			},
+			attrs: map[string]attr.Value{
+				"string": NewBoolValue(true),
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"bool":   BoolType{},
+				"string": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"While creating a Object value, an invalid attribute value was detected. "+
+						"A Object must use a matching attribute type for the value. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (string) Expected Type: basetypes.StringType\n"+
+						"Object Attribute Name (string) Given Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.objectType.NewValuePartial(context.Background(), testCase.attrs)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
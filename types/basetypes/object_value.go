@@ -242,6 +242,529 @@ func (o ObjectValue) Attributes() map[string]attr.Value {
 	return result
 }
 
+// Attribute returns the named attribute value of the Object. An error
+// diagnostic is returned if the Object is null or unknown, since no
+// attributes are available, or if the Object has no attribute with the name.
+func (o ObjectValue) Attribute(name string) (attr.Value, diag.Diagnostics) {
+	diags := o.validateAttribute("get", name)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return o.attributes[name], diags
+}
+
+// validateAttribute returns an error diagnostic if the Object is null or
+// unknown, or has no attribute with the name, describing the attempted
+// `operation` on the attribute, such as "get".
+func (o ObjectValue) validateAttribute(operation string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch o.state {
+	case attr.ValueStateNull:
+		diags.AddError(
+			"Null Object Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s the %q object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation, name)+
+				"The object is null, so no attributes are available.",
+		)
+
+		return diags
+	case attr.ValueStateUnknown:
+		diags.AddError(
+			"Unknown Object Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s the %q object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation, name)+
+				"The object is unknown, so no attributes are available.",
+		)
+
+		return diags
+	}
+
+	if _, ok := o.attributes[name]; !ok {
+		diags.AddError(
+			"Missing Object Attribute",
+			fmt.Sprintf("An unexpected error was encountered trying to %s the %q object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation, name)+
+				fmt.Sprintf("The object has no attribute named %q.", name),
+		)
+	}
+
+	return diags
+}
+
+// BoolAttribute returns the named attribute value of the Object as a Bool,
+// converting custom BoolValuable values with ToBoolValue. An error diagnostic is
+// returned if the attribute is not available, as with Attribute, or if the
+// attribute value is not a BoolValuable.
+func (o ObjectValue) BoolAttribute(name string) (BoolValue, diag.Diagnostics) {
+	value, diags := o.Attribute(name)
+
+	if diags.HasError() {
+		return NewBoolUnknown(), diags
+	}
+
+	valuable, ok := value.(BoolValuable)
+
+	if !ok {
+		diags.Append(objectAttributeTypeMismatchDiagnostics(name, "Bool", value)...)
+
+		return NewBoolUnknown(), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	boolValue, boolDiags := valuable.ToBoolValue(ctx)
+
+	diags.Append(boolDiags...)
+
+	return boolValue, diags
+}
+
+// Float64Attribute returns the named attribute value of the Object as a Float64,
+// converting custom Float64Valuable values with ToFloat64Value. An error diagnostic is
+// returned if the attribute is not available, as with Attribute, or if the
+// attribute value is not a Float64Valuable.
+func (o ObjectValue) Float64Attribute(name string) (Float64Value, diag.Diagnostics) {
+	value, diags := o.Attribute(name)
+
+	if diags.HasError() {
+		return NewFloat64Unknown(), diags
+	}
+
+	valuable, ok := value.(Float64Valuable)
+
+	if !ok {
+		diags.Append(objectAttributeTypeMismatchDiagnostics(name, "Float64", value)...)
+
+		return NewFloat64Unknown(), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	float64Value, float64Diags := valuable.ToFloat64Value(ctx)
+
+	diags.Append(float64Diags...)
+
+	return float64Value, diags
+}
+
+// Int64Attribute returns the named attribute value of the Object as a Int64,
+// converting custom Int64Valuable values with ToInt64Value. An error diagnostic is
+// returned if the attribute is not available, as with Attribute, or if the
+// attribute value is not a Int64Valuable.
+func (o ObjectValue) Int64Attribute(name string) (Int64Value, diag.Diagnostics) {
+	value, diags := o.Attribute(name)
+
+	if diags.HasError() {
+		return NewInt64Unknown(), diags
+	}
+
+	valuable, ok := value.(Int64Valuable)
+
+	if !ok {
+		diags.Append(objectAttributeTypeMismatchDiagnostics(name, "Int64", value)...)
+
+		return NewInt64Unknown(), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	int64Value, int64Diags := valuable.ToInt64Value(ctx)
+
+	diags.Append(int64Diags...)
+
+	return int64Value, diags
+}
+
+// NumberAttribute returns the named attribute value of the Object as a Number,
+// converting custom NumberValuable values with ToNumberValue. An error diagnostic is
+// returned if the attribute is not available, as with Attribute, or if the
+// attribute value is not a NumberValuable.
+func (o ObjectValue) NumberAttribute(name string) (NumberValue, diag.Diagnostics) {
+	value, diags := o.Attribute(name)
+
+	if diags.HasError() {
+		return NewNumberUnknown(), diags
+	}
+
+	valuable, ok := value.(NumberValuable)
+
+	if !ok {
+		diags.Append(objectAttributeTypeMismatchDiagnostics(name, "Number", value)...)
+
+		return NewNumberUnknown(), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	numberValue, numberDiags := valuable.ToNumberValue(ctx)
+
+	diags.Append(numberDiags...)
+
+	return numberValue, diags
+}
+
+// StringAttribute returns the named attribute value of the Object as a String,
+// converting custom StringValuable values with ToStringValue. An error diagnostic is
+// returned if the attribute is not available, as with Attribute, or if the
+// attribute value is not a StringValuable.
+func (o ObjectValue) StringAttribute(name string) (StringValue, diag.Diagnostics) {
+	value, diags := o.Attribute(name)
+
+	if diags.HasError() {
+		return NewStringUnknown(), diags
+	}
+
+	valuable, ok := value.(StringValuable)
+
+	if !ok {
+		diags.Append(objectAttributeTypeMismatchDiagnostics(name, "String", value)...)
+
+		return NewStringUnknown(), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	stringValue, stringDiags := valuable.ToStringValue(ctx)
+
+	diags.Append(stringDiags...)
+
+	return stringValue, diags
+}
+
+// objectAccessorContext returns the context for ObjectValue methods which
+// do not accept one, such as Attribute and WithAttributeValue.
+//
+// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+func objectAccessorContext() context.Context {
+	return context.Background()
+}
+
+// WithAttributeValue returns a copy of the Object with the named attribute
+// value replaced by `value`. The Object itself is not modified. An error
+// diagnostic is returned if the Object is null or unknown, as with
+// Attribute, if the Object has no attribute with the name, or if the type of
+// `value` does not equal the attribute type.
+func (o ObjectValue) WithAttributeValue(name string, value attr.Value) (ObjectValue, diag.Diagnostics) {
+	diags := o.validateAttribute("set", name)
+
+	if diags.HasError() {
+		return NewObjectUnknown(o.attributeTypes), diags
+	}
+
+	ctx := objectAccessorContext()
+
+	attributeType := o.attributeTypes[name]
+
+	if value == nil || !attributeType.Equal(value.Type(ctx)) {
+		var valueType attr.Type
+
+		if value != nil {
+			valueType = value.Type(ctx)
+		}
+
+		diags.AddError(
+			"Invalid Object Attribute Type",
+			fmt.Sprintf("An unexpected error was encountered trying to set the %q object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n", name)+
+				fmt.Sprintf("Object Attribute Name (%s) Expected Type: %s\n", name, attributeType)+
+				fmt.Sprintf("Object Attribute Name (%s) Given Type: %v", name, valueType),
+		)
+
+		return NewObjectUnknown(o.attributeTypes), diags
+	}
+
+	result := o
+	result.attributes = o.Attributes()
+	result.attributes[name] = value
+
+	return result, diags
+}
+
+// RenameAttributes returns a new Object of the `target` type, such as during
+// a resource state upgrade, with the attribute values of the Object moved
+// from their old names to the new names in `renames`, which maps old to new
+// attribute names. Attributes without a rename keep their name. Attributes of
+// `target` without a value are set to null, as with ObjectType
+// NewValuePartial. Null and unknown Objects return a null or unknown Object
+// of the `target` type.
+//
+// An error diagnostic is returned if an attribute name is not defined in
+// `target` after renaming, if more than one attribute is given the same
+// name, or if the type of an attribute value does not match the `target`
+// attribute type.
+func (o ObjectValue) RenameAttributes(ctx context.Context, renames map[string]string, target ObjectType) (ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch o.state {
+	case attr.ValueStateNull:
+		return NewObjectNull(target.AttrTypes), diags
+	case attr.ValueStateUnknown:
+		return NewObjectUnknown(target.AttrTypes), diags
+	}
+
+	names := make([]string, 0, len(o.attributes))
+
+	for name := range o.attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	attributes := make(map[string]attr.Value, len(o.attributes))
+	oldNames := make(map[string]string, len(o.attributes))
+
+	for _, oldName := range names {
+		newName, ok := renames[oldName]
+
+		if !ok {
+			newName = oldName
+		}
+
+		if _, ok := target.AttrTypes[newName]; !ok {
+			detail := fmt.Sprintf("Object attribute %q is not renamed and is not defined in the target object type.", oldName)
+
+			if newName != oldName {
+				detail = fmt.Sprintf("Object attribute %q is renamed to %q, which is not defined in the target object type.", oldName, newName)
+			}
+
+			diags.AddError(
+				"Undefined Object Attribute",
+				"An unexpected error was encountered trying to rename object attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					detail,
+			)
+
+			continue
+		}
+
+		if previousName, ok := oldNames[newName]; ok {
+			diags.AddError(
+				"Duplicate Object Attribute",
+				"An unexpected error was encountered trying to rename object attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Object attributes %q and %q are both renamed to %q.", previousName, oldName, newName),
+			)
+
+			continue
+		}
+
+		oldNames[newName] = oldName
+		attributes[newName] = o.attributes[oldName]
+	}
+
+	if diags.HasError() {
+		return NewObjectUnknown(target.AttrTypes), diags
+	}
+
+	return target.NewValuePartial(ctx, attributes)
+}
+
+// Diff returns the paths, relative to the Object, of the attributes whose
+// values differ from `other`, such as when comparing a plan and prior state,
+// sorted by attribute name. Nested objects and collections are compared
+// recursively, so a changed field of a nested object returns the path of that
+// field. List elements are compared by index and map elements by key, while
+// set elements present in only one of the sets return their set value path.
+// A value which changed state, such as from null to known, returns the path of
+// the value itself. If either Object is null or unknown and the Objects are
+// not equal, the empty path is returned.
+//
+// An error diagnostic is returned if the Object types are not equal.
+func (o ObjectValue) Diff(ctx context.Context, other ObjectValue) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !o.Type(ctx).Equal(other.Type(ctx)) {
+		diags.AddError(
+			"Object Type Mismatch",
+			"An unexpected error was encountered trying to diff objects. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Object Type: %s\n", o.Type(ctx))+
+				fmt.Sprintf("Other Object Type: %s", other.Type(ctx)),
+		)
+
+		return nil, diags
+	}
+
+	return valueDiffPaths(ctx, path.Empty(), o, other)
+}
+
+// valueDiffPaths returns the paths of the values which differ between `a`
+// and `b`, which are at `p` and are of equal types.
+func valueDiffPaths(ctx context.Context, p path.Path, a, b attr.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if a.Equal(b) {
+		return nil, diags
+	}
+
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return path.Paths{p}, diags
+	}
+
+	var paths path.Paths
+
+	switch aValuable := a.(type) {
+	case ObjectValuable:
+		bValuable, ok := b.(ObjectValuable)
+
+		if !ok {
+			return path.Paths{p}, diags
+		}
+
+		aObject, aDiags := aValuable.ToObjectValue(ctx)
+		diags.Append(aDiags...)
+
+		bObject, bDiags := bValuable.ToObjectValue(ctx)
+		diags.Append(bDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		names := make([]string, 0, len(aObject.attributes))
+
+		for name := range aObject.attributes {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			bAttribute, ok := bObject.attributes[name]
+
+			if !ok {
+				paths = append(paths, p.AtName(name))
+
+				continue
+			}
+
+			attributePaths, attributeDiags := valueDiffPaths(ctx, p.AtName(name), aObject.attributes[name], bAttribute)
+			diags.Append(attributeDiags...)
+			paths = append(paths, attributePaths...)
+		}
+	case ListValuable:
+		bValuable, ok := b.(ListValuable)
+
+		if !ok {
+			return path.Paths{p}, diags
+		}
+
+		aList, aDiags := aValuable.ToListValue(ctx)
+		diags.Append(aDiags...)
+
+		bList, bDiags := bValuable.ToListValue(ctx)
+		diags.Append(bDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if len(aList.elements) != len(bList.elements) {
+			return path.Paths{p}, diags
+		}
+
+		for index, aElement := range aList.elements {
+			elementPaths, elementDiags := valueDiffPaths(ctx, p.AtListIndex(index), aElement, bList.elements[index])
+			diags.Append(elementDiags...)
+			paths = append(paths, elementPaths...)
+		}
+	case MapValuable:
+		bValuable, ok := b.(MapValuable)
+
+		if !ok {
+			return path.Paths{p}, diags
+		}
+
+		aMap, aDiags := aValuable.ToMapValue(ctx)
+		diags.Append(aDiags...)
+
+		bMap, bDiags := bValuable.ToMapValue(ctx)
+		diags.Append(bDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		keys := make([]string, 0, len(aMap.elements)+len(bMap.elements))
+
+		for key := range aMap.elements {
+			keys = append(keys, key)
+		}
+
+		for key := range bMap.elements {
+			if _, ok := aMap.elements[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			aElement, aOk := aMap.elements[key]
+			bElement, bOk := bMap.elements[key]
+
+			if !aOk || !bOk {
+				paths = append(paths, p.AtMapKey(key))
+
+				continue
+			}
+
+			elementPaths, elementDiags := valueDiffPaths(ctx, p.AtMapKey(key), aElement, bElement)
+			diags.Append(elementDiags...)
+			paths = append(paths, elementPaths...)
+		}
+	case SetValuable:
+		bValuable, ok := b.(SetValuable)
+
+		if !ok {
+			return path.Paths{p}, diags
+		}
+
+		aSet, aDiags := aValuable.ToSetValue(ctx)
+		diags.Append(aDiags...)
+
+		bSet, bDiags := bValuable.ToSetValue(ctx)
+		diags.Append(bDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, aElement := range aSet.elements {
+			if !bSet.contains(aElement) {
+				paths = append(paths, p.AtSetValue(aElement))
+			}
+		}
+
+		for _, bElement := range bSet.elements {
+			if !aSet.contains(bElement) {
+				paths = append(paths, p.AtSetValue(bElement))
+			}
+		}
+	default:
+		return path.Paths{p}, diags
+	}
+
+	// Values which are not equal, but have no differing nested values, such
+	// as custom values with additional data, return their own path.
+	if len(paths) == 0 && !diags.HasError() {
+		return path.Paths{p}, diags
+	}
+
+	return paths, diags
+}
+
+// objectAttributeTypeMismatchDiagnostics returns the error diagnostic for an
+// object attribute value which is not of the expected kind of value, such as
+// "String".
+func objectAttributeTypeMismatchDiagnostics(name string, expected string, value attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Object Attribute Type Mismatch",
+		fmt.Sprintf("An unexpected error was encountered trying to get the %q object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n", name)+
+			fmt.Sprintf("Expected basetypes.%sValuable, got: %T", expected, value),
+	)
+
+	return diags
+}
+
 // AttributeTypes returns a copy of the mapping of attribute types for the Object.
 func (o ObjectValue) AttributeTypes(_ context.Context) map[string]attr.Type {
 	// Ensure callers cannot mutate the internal attribute types
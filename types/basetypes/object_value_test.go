@@ -1751,3 +1751,716 @@ func TestObjectValueType(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectValueAttribute(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name":  StringType{},
+		"count": Int64Type{},
+	}
+
+	object := NewObjectValueMust(
+		attributeTypes,
+		map[string]attr.Value{
+			"name":  NewStringValue("example"),
+			"count": NewInt64Value(3),
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ObjectValue
+		name          string
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"present": {
+			input:    object,
+			name:     "name",
+			expected: NewStringValue("example"),
+		},
+		"missing": {
+			input: object,
+			name:  "missing",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute",
+					"An unexpected error was encountered trying to get the \"missing\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object has no attribute named \"missing\".",
+				),
+			},
+		},
+		"null": {
+			input: NewObjectNull(attributeTypes),
+			name:  "name",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Object Value",
+					"An unexpected error was encountered trying to get the \"name\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object is null, so no attributes are available.",
+				),
+			},
+		},
+		"unknown": {
+			input: NewObjectUnknown(attributeTypes),
+			name:  "name",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Object Value",
+					"An unexpected error was encountered trying to get the \"name\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object is unknown, so no attributes are available.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Attribute(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueStringAttribute(t *testing.T) {
+	t.Parallel()
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"name":   StringType{},
+			"count":  Int64Type{},
+			"custom": customStringType{},
+		},
+		map[string]attr.Value{
+			"name":   NewStringValue("example"),
+			"count":  NewInt64Value(3),
+			"custom": customStringValue{StringValue: NewStringValue("custom")},
+		},
+	)
+
+	testCases := map[string]struct {
+		name          string
+		expected      StringValue
+		expectedDiags diag.Diagnostics
+	}{
+		"present": {
+			name:     "name",
+			expected: NewStringValue("example"),
+		},
+		"custom-type": {
+			name:     "custom",
+			expected: NewStringValue("custom"),
+		},
+		"missing": {
+			name:     "missing",
+			expected: NewStringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute",
+					"An unexpected error was encountered trying to get the \"missing\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object has no attribute named \"missing\".",
+				),
+			},
+		},
+		"type-mismatch": {
+			name:     "count",
+			expected: NewStringUnknown(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Attribute Type Mismatch",
+					"An unexpected error was encountered trying to get the \"count\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected basetypes.StringValuable, got: basetypes.Int64Value",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := object.StringAttribute(testCase.name)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueInt64Attribute(t *testing.T) {
+	t.Parallel()
+
+	object := NewObjectValueMust(
+		map[string]attr.Type{
+			"name":  StringType{},
+			"count": Int64Type{},
+		},
+		map[string]attr.Value{
+			"name":  NewStringValue("example"),
+			"count": NewInt64Value(3),
+		},
+	)
+
+	got, diags := object.Int64Attribute("count")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !got.Equal(NewInt64Value(3)) {
+		t.Errorf("expected 3, got %s", got)
+	}
+
+	_, diags = object.Int64Attribute("name")
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"Object Attribute Type Mismatch",
+			"An unexpected error was encountered trying to get the \"name\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Expected basetypes.Int64Valuable, got: basetypes.StringValue",
+		),
+	}
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+	}
+}
+
+func TestObjectValueWithAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name":  StringType{},
+		"count": Int64Type{},
+	}
+
+	object := NewObjectValueMust(
+		attributeTypes,
+		map[string]attr.Value{
+			"name":  NewStringValue("example"),
+			"count": NewInt64Value(3),
+		},
+	)
+
+	testCases := map[string]struct {
+		input         ObjectValue
+		name          string
+		value         attr.Value
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}{
+		"replace": {
+			input: object,
+			name:  "name",
+			value: NewStringValue("updated"),
+			expected: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("updated"),
+					"count": NewInt64Value(3),
+				},
+			),
+		},
+		"replace-null": {
+			input: object,
+			name:  "count",
+			value: NewInt64Null(),
+			expected: NewObjectValueMust(
+				attributeTypes,
+				map[string]attr.Value{
+					"name":  NewStringValue("example"),
+					"count": NewInt64Null(),
+				},
+			),
+		},
+		"type-mismatch": {
+			input:    object,
+			name:     "count",
+			value:    NewStringValue("three"),
+			expected: NewObjectUnknown(attributeTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"An unexpected error was encountered trying to set the \"count\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object Attribute Name (count) Expected Type: basetypes.Int64Type\n"+
+						"Object Attribute Name (count) Given Type: basetypes.StringType",
+				),
+			},
+		},
+		"nil-value": {
+			input:    object,
+			name:     "count",
+			value:    nil,
+			expected: NewObjectUnknown(attributeTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"An unexpected error was encountered trying to set the \"count\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object Attribute Name (count) Expected Type: basetypes.Int64Type\n"+
+						"Object Attribute Name (count) Given Type: <nil>",
+				),
+			},
+		},
+		"missing": {
+			input:    object,
+			name:     "missing",
+			value:    NewStringValue("value"),
+			expected: NewObjectUnknown(attributeTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Object Attribute",
+					"An unexpected error was encountered trying to set the \"missing\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object has no attribute named \"missing\".",
+				),
+			},
+		},
+		"null": {
+			input:    NewObjectNull(attributeTypes),
+			name:     "name",
+			value:    NewStringValue("updated"),
+			expected: NewObjectUnknown(attributeTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Object Value",
+					"An unexpected error was encountered trying to set the \"name\" object attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The object is null, so no attributes are available.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.WithAttributeValue(testCase.name, testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+
+	// The original value must be unchanged.
+	if got := object.Attributes()["name"]; !got.Equal(NewStringValue("example")) {
+		t.Errorf("expected original object to be unchanged, got name: %s", got)
+	}
+}
+
+func TestObjectValueRenameAttributes(t *testing.T) {
+	t.Parallel()
+
+	oldAttributeTypes := map[string]attr.Type{
+		"name":    StringType{},
+		"old_ttl": Int64Type{},
+	}
+
+	testCases := map[string]struct {
+		input         ObjectValue
+		renames       map[string]string
+		target        ObjectType
+		expected      ObjectValue
+		expectedDiags diag.Diagnostics
+	}{
+		"rename": {
+			input: NewObjectValueMust(
+				oldAttributeTypes,
+				map[string]attr.Value{
+					"name":    NewStringValue("test"),
+					"old_ttl": NewInt64Value(30),
+				},
+			),
+			renames: map[string]string{
+				"old_ttl": "ttl",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"enabled": BoolType{},
+					"name":    StringType{},
+					"ttl":     Int64Type{},
+				},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"enabled": BoolType{},
+					"name":    StringType{},
+					"ttl":     Int64Type{},
+				},
+				map[string]attr.Value{
+					"enabled": NewBoolNull(),
+					"name":    NewStringValue("test"),
+					"ttl":     NewInt64Value(30),
+				},
+			),
+		},
+		"rename-swap": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{
+					"a": StringType{},
+					"b": StringType{},
+				},
+				map[string]attr.Value{
+					"a": NewStringValue("a-value"),
+					"b": NewStringValue("b-value"),
+				},
+			),
+			renames: map[string]string{
+				"a": "b",
+				"b": "a",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"a": StringType{},
+					"b": StringType{},
+				},
+			},
+			expected: NewObjectValueMust(
+				map[string]attr.Type{
+					"a": StringType{},
+					"b": StringType{},
+				},
+				map[string]attr.Value{
+					"a": NewStringValue("b-value"),
+					"b": NewStringValue("a-value"),
+				},
+			),
+		},
+		"rename-type-changed": {
+			input: NewObjectValueMust(
+				oldAttributeTypes,
+				map[string]attr.Value{
+					"name":    NewStringValue("test"),
+					"old_ttl": NewInt64Value(30),
+				},
+			),
+			renames: map[string]string{
+				"old_ttl": "ttl",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": StringType{},
+					"ttl":  StringType{},
+				},
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"name": StringType{},
+				"ttl":  StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Object Attribute Type",
+					"While creating a Object value, an invalid attribute value was detected. "+
+						"A Object must use a matching attribute type for the value. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Object Attribute Name (ttl) Expected Type: basetypes.StringType\n"+
+						"Object Attribute Name (ttl) Given Type: basetypes.Int64Type",
+				),
+			},
+		},
+		"undefined-attribute": {
+			input: NewObjectValueMust(
+				oldAttributeTypes,
+				map[string]attr.Value{
+					"name":    NewStringValue("test"),
+					"old_ttl": NewInt64Value(30),
+				},
+			),
+			renames: map[string]string{
+				"name": "title",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": StringType{},
+				},
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"name": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Undefined Object Attribute",
+					"An unexpected error was encountered trying to rename object attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object attribute \"name\" is renamed to \"title\", which is not defined in the target object type.",
+				),
+				diag.NewErrorDiagnostic(
+					"Undefined Object Attribute",
+					"An unexpected error was encountered trying to rename object attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object attribute \"old_ttl\" is not renamed and is not defined in the target object type.",
+				),
+			},
+		},
+		"duplicate-attribute": {
+			input: NewObjectValueMust(
+				map[string]attr.Type{
+					"name":     StringType{},
+					"old_name": StringType{},
+				},
+				map[string]attr.Value{
+					"name":     NewStringValue("new"),
+					"old_name": NewStringValue("old"),
+				},
+			),
+			renames: map[string]string{
+				"old_name": "name",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": StringType{},
+				},
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"name": StringType{},
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Object Attribute",
+					"An unexpected error was encountered trying to rename object attributes. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object attributes \"name\" and \"old_name\" are both renamed to \"name\".",
+				),
+			},
+		},
+		"null": {
+			input: NewObjectNull(oldAttributeTypes),
+			renames: map[string]string{
+				"old_ttl": "ttl",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"ttl": Int64Type{},
+				},
+			},
+			expected: NewObjectNull(map[string]attr.Type{
+				"ttl": Int64Type{},
+			}),
+		},
+		"unknown": {
+			input: NewObjectUnknown(oldAttributeTypes),
+			renames: map[string]string{
+				"old_ttl": "ttl",
+			},
+			target: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"ttl": Int64Type{},
+				},
+			},
+			expected: NewObjectUnknown(map[string]attr.Type{
+				"ttl": Int64Type{},
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.RenameAttributes(context.Background(), testCase.renames, testCase.target)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValueDiff(t *testing.T) {
+	t.Parallel()
+
+	attributeTypes := map[string]attr.Type{
+		"name": StringType{},
+		"network": ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"cidr": StringType{},
+				"dns":  BoolType{},
+			},
+		},
+		"ports": ListType{ElemType: Int64Type{}},
+		"tags":  MapType{ElemType: StringType{}},
+		"zones": SetType{ElemType: StringType{}},
+	}
+
+	newObject := func(name string, cidr string, ports []attr.Value, tags map[string]attr.Value, zones []attr.Value) ObjectValue {
+		return NewObjectValueMust(
+			attributeTypes,
+			map[string]attr.Value{
+				"name": NewStringValue(name),
+				"network": NewObjectValueMust(
+					map[string]attr.Type{
+						"cidr": StringType{},
+						"dns":  BoolType{},
+					},
+					map[string]attr.Value{
+						"cidr": NewStringValue(cidr),
+						"dns":  NewBoolValue(true),
+					},
+				),
+				"ports": NewListValueMust(Int64Type{}, ports),
+				"tags":  NewMapValueMust(StringType{}, tags),
+				"zones": NewSetValueMust(StringType{}, zones),
+			},
+		)
+	}
+
+	ports := []attr.Value{NewInt64Value(80), NewInt64Value(443)}
+	tags := map[string]attr.Value{"env": NewStringValue("test")}
+	zones := []attr.Value{NewStringValue("a"), NewStringValue("b")}
+
+	testCases := map[string]struct {
+		input         ObjectValue
+		other         ObjectValue
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"identical": {
+			input:    newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other:    newObject("test", "10.0.0.0/16", ports, tags, zones),
+			expected: nil,
+		},
+		"scalar": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("changed", "10.0.0.0/16", ports, tags, zones),
+			expected: path.Paths{
+				path.Root("name"),
+			},
+		},
+		"nested-object": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("test", "10.1.0.0/16", ports, tags, zones),
+			expected: path.Paths{
+				path.Root("network").AtName("cidr"),
+			},
+		},
+		"multiple": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("changed", "10.1.0.0/16", ports, tags, zones),
+			expected: path.Paths{
+				path.Root("name"),
+				path.Root("network").AtName("cidr"),
+			},
+		},
+		"list-element": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("test", "10.0.0.0/16", []attr.Value{NewInt64Value(80), NewInt64Value(8443)}, tags, zones),
+			expected: path.Paths{
+				path.Root("ports").AtListIndex(1),
+			},
+		},
+		"list-length": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("test", "10.0.0.0/16", []attr.Value{NewInt64Value(80)}, tags, zones),
+			expected: path.Paths{
+				path.Root("ports"),
+			},
+		},
+		"map-elements": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("test", "10.0.0.0/16", ports, map[string]attr.Value{"env": NewStringValue("prod"), "team": NewStringValue("a")}, zones),
+			expected: path.Paths{
+				path.Root("tags").AtMapKey("env"),
+				path.Root("tags").AtMapKey("team"),
+			},
+		},
+		"set-elements": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: newObject("test", "10.0.0.0/16", ports, tags, []attr.Value{NewStringValue("a"), NewStringValue("c")}),
+			expected: path.Paths{
+				path.Root("zones").AtSetValue(NewStringValue("b")),
+				path.Root("zones").AtSetValue(NewStringValue("c")),
+			},
+		},
+		"null-attribute": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: func() ObjectValue {
+				o := newObject("test", "10.0.0.0/16", ports, tags, zones)
+				o, _ = o.WithAttributeValue("network", NewObjectNull(map[string]attr.Type{
+					"cidr": StringType{},
+					"dns":  BoolType{},
+				}))
+
+				return o
+			}(),
+			expected: path.Paths{
+				path.Root("network"),
+			},
+		},
+		"null": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: NewObjectNull(attributeTypes),
+			expected: path.Paths{
+				path.Empty(),
+			},
+		},
+		"unknown-unknown": {
+			input:    NewObjectUnknown(attributeTypes),
+			other:    NewObjectUnknown(attributeTypes),
+			expected: nil,
+		},
+		"type-mismatch": {
+			input: newObject("test", "10.0.0.0/16", ports, tags, zones),
+			other: NewObjectValueMust(
+				map[string]attr.Type{
+					"name": StringType{},
+				},
+				map[string]attr.Value{
+					"name": NewStringValue("test"),
+				},
+			),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Object Type Mismatch",
+					"An unexpected error was encountered trying to diff objects. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Object Type: types.ObjectType[\"name\":basetypes.StringType, \"network\":types.ObjectType[\"cidr\":basetypes.StringType, \"dns\":basetypes.BoolType], \"ports\":types.ListType[basetypes.Int64Type], \"tags\":types.MapType[basetypes.StringType], \"zones\":types.SetType[basetypes.StringType]]\n"+
+						"Other Object Type: types.ObjectType[\"name\":basetypes.StringType]",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Diff(context.Background(), testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ MapTypable = OrderedMapType{}
+
+// OrderedMapType is a MapType whose values are OrderedMapValue, which keep
+// an explicit key order alongside the map elements, such as the order a
+// provider received keys from a remote system for display purposes. Values
+// are still represented as tftypes.Map in Terraform.
+//
+// Terraform does not preserve map key order, so values created by the
+// framework from Terraform data, such as configuration, plan, or state, are
+// always in sorted key order. Only values created in provider code, such as
+// with NewOrderedMapValue, have another key order.
+type OrderedMapType struct {
+	MapType
+}
+
+// WithElementType returns a new copy of the type with its element type set.
+func (t OrderedMapType) WithElementType(typ attr.Type) attr.TypeWithElementType {
+	return OrderedMapType{
+		MapType: t.MapType.WithElementType(typ).(MapType),
+	}
+}
+
+// Equal returns true if the given type is also an OrderedMapType and has the
+// same ElemType.
+func (t OrderedMapType) Equal(o attr.Type) bool {
+	other, ok := o.(OrderedMapType)
+
+	if !ok {
+		return false
+	}
+
+	return t.MapType.Equal(other.MapType)
+}
+
+// String returns a human-friendly description of the OrderedMapType.
+func (t OrderedMapType) String() string {
+	return "basetypes.OrderedMapType[" + t.ElemType.String() + "]"
+}
+
+// ValueFromTerraform returns an OrderedMapValue given a tftypes.Value, with
+// the keys in sorted order.
+func (t OrderedMapType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	v, err := t.MapType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	mapValue, ok := v.(MapValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	return newOrderedMapValueSorted(mapValue), nil
+}
+
+// ValueType returns the Value type.
+func (t OrderedMapType) ValueType(ctx context.Context) attr.Value {
+	return OrderedMapValue{
+		MapValue: t.MapType.ValueType(ctx).(MapValue),
+	}
+}
+
+// ValueFromMap returns an OrderedMapValue given a Map, with the keys in
+// sorted order.
+func (t OrderedMapType) ValueFromMap(_ context.Context, ma MapValue) (MapValuable, diag.Diagnostics) {
+	return newOrderedMapValueSorted(ma), nil
+}
+
+// CopyValue returns a deep copy of the given known OrderedMapValue, with fresh
+// element and key storage, the same key order, and the same state redacted
+// paths. Elements are copied with attr.Copy.
+func (t OrderedMapType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	orderedMap, ok := v.(OrderedMapValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	elements, err := copyMapValues(ctx, orderedMap.elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := OrderedMapValue{
+		MapValue: orderedMap.MapValue,
+		keys:     orderedMap.OrderedKeys(),
+	}
+
+	result.elements = elements
+	result.stateRedactedPaths = copyPaths(orderedMap.stateRedactedPaths)
+
+	return result, nil
+}
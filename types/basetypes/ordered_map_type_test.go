@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrderedMapTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input        tftypes.Value
+		expected     attr.Value
+		expectedKeys []string
+	}{
+		"known": {
+			input: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"b": tftypes.NewValue(tftypes.String, "two"),
+				"c": tftypes.NewValue(tftypes.String, "three"),
+				"a": tftypes.NewValue(tftypes.String, "one"),
+			}),
+			expected: NewOrderedMapValueMust(
+				StringType{},
+				[]string{"a", "b", "c"},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringValue("two"),
+					"c": NewStringValue("three"),
+				},
+			),
+			expectedKeys: []string{"a", "b", "c"},
+		},
+		"null": {
+			input:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			expected: NewOrderedMapNull(StringType{}),
+		},
+		"unknown": {
+			input:    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+			expected: NewOrderedMapUnknown(StringType{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := OrderedMapType{MapType: MapType{ElemType: StringType{}}}.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+
+			if diff := cmp.Diff(got.(OrderedMapValue).OrderedKeys(), testCase.expectedKeys); diff != "" {
+				t.Errorf("unexpected keys difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrderedMapTypeValueFromTerraform_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	orderedMapType := OrderedMapType{MapType: MapType{ElemType: StringType{}}}
+
+	value := NewOrderedMapValueMust(
+		StringType{},
+		[]string{"c", "a", "b"},
+		map[string]attr.Value{
+			"a": NewStringValue("one"),
+			"b": NewStringValue("two"),
+			"c": NewStringValue("three"),
+		},
+	)
+
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedTfValue := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+		"a": tftypes.NewValue(tftypes.String, "one"),
+		"b": tftypes.NewValue(tftypes.String, "two"),
+		"c": tftypes.NewValue(tftypes.String, "three"),
+	})
+
+	if diff := cmp.Diff(tfValue, expectedTfValue); diff != "" {
+		t.Errorf("unexpected Terraform value difference: %s", diff)
+	}
+
+	got, err := orderedMapType.ValueFromTerraform(ctx, tfValue)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.Equal(value) {
+		t.Errorf("expected %s, got %s", value, got)
+	}
+
+	// The key order is not preserved by Terraform, so reverts to sorted.
+	if diff := cmp.Diff(got.(OrderedMapValue).OrderedKeys(), []string{"a", "b", "c"}); diff != "" {
+		t.Errorf("unexpected keys difference: %s", diff)
+	}
+
+	if !got.Type(ctx).Equal(orderedMapType) {
+		t.Errorf("expected type %s, got %s", orderedMapType, got.Type(ctx))
+	}
+}
+
+func TestOrderedMapTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    OrderedMapType
+		other    attr.Type
+		expected bool
+	}{
+		"equal": {
+			input:    OrderedMapType{MapType: MapType{ElemType: StringType{}}},
+			other:    OrderedMapType{MapType: MapType{ElemType: StringType{}}},
+			expected: true,
+		},
+		"different-element-type": {
+			input:    OrderedMapType{MapType: MapType{ElemType: StringType{}}},
+			other:    OrderedMapType{MapType: MapType{ElemType: BoolType{}}},
+			expected: false,
+		},
+		"map-type": {
+			input:    OrderedMapType{MapType: MapType{ElemType: StringType{}}},
+			other:    MapType{ElemType: StringType{}},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
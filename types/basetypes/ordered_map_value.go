@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ MapValuable = OrderedMapValue{}
+
+// OrderedMapValue is a Map which keeps an explicit key order alongside its
+// elements. The key order is not sent to Terraform, which only receives the
+// map elements, so values converted from Terraform data are in sorted key
+// order. Refer to OrderedMapType for more information.
+type OrderedMapValue struct {
+	MapValue
+
+	// keys is the order of the MapValue element keys.
+	keys []string
+}
+
+// NewOrderedMapNull creates an OrderedMap with a null value. Determine
+// whether the value is null via the IsNull method.
+func NewOrderedMapNull(elementType attr.Type) OrderedMapValue {
+	return OrderedMapValue{
+		MapValue: NewMapNull(elementType),
+	}
+}
+
+// NewOrderedMapUnknown creates an OrderedMap with an unknown value. Determine
+// whether the value is unknown via the IsUnknown method.
+func NewOrderedMapUnknown(elementType attr.Type) OrderedMapValue {
+	return OrderedMapValue{
+		MapValue: NewMapUnknown(elementType),
+	}
+}
+
+// NewOrderedMapValue creates an OrderedMap with a known value, whose keys are
+// ordered as in `keys`. An error diagnostic is returned if `keys` does not
+// contain every key of `elements` exactly once, or if an element type does not
+// equal the given element type, as with NewMapValue.
+func NewOrderedMapValue(elementType attr.Type, keys []string, elements map[string]attr.Value) (OrderedMapValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	seen := make(map[string]struct{}, len(keys))
+
+	for _, key := range keys {
+		if _, ok := elements[key]; !ok {
+			diags.AddError(
+				"Invalid Ordered Map Key",
+				"While creating an Ordered Map value, a key without an element was detected. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Ordered Map Key: %s", key),
+			)
+
+			continue
+		}
+
+		if _, ok := seen[key]; ok {
+			diags.AddError(
+				"Duplicate Ordered Map Key",
+				"While creating an Ordered Map value, a duplicate key was detected. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Ordered Map Key: %s", key),
+			)
+
+			continue
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	for _, key := range (MapValue{elements: elements}).ElementsSortedKeys() {
+		if _, ok := seen[key]; !ok {
+			diags.AddError(
+				"Missing Ordered Map Key",
+				"While creating an Ordered Map value, an element without an ordered key was detected. "+
+					"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+					fmt.Sprintf("Map Key: %s", key),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return NewOrderedMapUnknown(elementType), diags
+	}
+
+	mapValue, mapDiags := NewMapValue(elementType, elements)
+
+	diags.Append(mapDiags...)
+
+	if diags.HasError() {
+		return NewOrderedMapUnknown(elementType), diags
+	}
+
+	return OrderedMapValue{
+		MapValue: mapValue,
+		keys:     append([]string(nil), keys...),
+	}, diags
+}
+
+// NewOrderedMapValueMust creates an OrderedMap with a known value, whose keys
+// are ordered as in `keys`. This creation function is only recommended to
+// create OrderedMap values which will not potentially affect practitioners,
+// such as testing, or exhaustively tested provider logic. If any
+// diagnostics are generated, this function will panic.
+func NewOrderedMapValueMust(elementType attr.Type, keys []string, elements map[string]attr.Value) OrderedMapValue {
+	m, diags := NewOrderedMapValue(elementType, keys, elements)
+
+	if diags.HasError() {
+		// This could potentially be added to the diag package.
+		diagsStrings := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			diagsStrings = append(diagsStrings, fmt.Sprintf(
+				"%s | %s | %s",
+				diagnostic.Severity(),
+				diagnostic.Summary(),
+				diagnostic.Detail()))
+		}
+
+		panic("NewOrderedMapValueMust received error(s): " + strings.Join(diagsStrings, "\n"))
+	}
+
+	return m
+}
+
+// newOrderedMapValueSorted returns an OrderedMap of the given Map, with the
+// keys in sorted order.
+func newOrderedMapValueSorted(m MapValue) OrderedMapValue {
+	result := OrderedMapValue{
+		MapValue: m,
+	}
+
+	if m.state == attr.ValueStateKnown {
+		result.keys = m.ElementsSortedKeys()
+	}
+
+	return result
+}
+
+// OrderedKeys returns a copy of the keys of the OrderedMap in order. If the
+// OrderedMap is null or unknown, returns nil.
+func (m OrderedMapValue) OrderedKeys() []string {
+	if m.state != attr.ValueStateKnown {
+		return nil
+	}
+
+	return append(make([]string, 0, len(m.keys)), m.keys...)
+}
+
+// Type returns an OrderedMapType with the same element type as `m`.
+func (m OrderedMapValue) Type(ctx context.Context) attr.Type {
+	return OrderedMapType{
+		MapType: MapType{
+			ElemType: m.ElementType(ctx),
+		},
+	}
+}
+
+// Equal returns true if the given attr.Value is also an OrderedMapValue and
+// the Maps are equal, as defined by the MapValue Equal method. The key order
+// is not compared, as Terraform does not preserve it.
+func (m OrderedMapValue) Equal(o attr.Value) bool {
+	other, ok := o.(OrderedMapValue)
+
+	if !ok {
+		return false
+	}
+
+	return m.MapValue.Equal(other.MapValue)
+}
+
+// String returns a human-readable representation of the OrderedMap value,
+// with the elements in key order. The string returned here is not protected
+// by any compatibility guarantees, and is intended for logging and error
+// reporting.
+func (m OrderedMapValue) String() string {
+	if m.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if m.IsNull() {
+		return attr.NullValueString
+	}
+
+	var res strings.Builder
+
+	res.WriteString("{")
+	for i, k := range m.keys {
+		if i != 0 {
+			res.WriteString(",")
+		}
+		res.WriteString(fmt.Sprintf("%q:%s", k, m.elements[k].String()))
+	}
+	res.WriteString("}")
+
+	return res.String()
+}
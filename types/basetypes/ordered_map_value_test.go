@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestNewOrderedMapValue(t *testing.T) {
+	t.Parallel()
+
+	elements := map[string]attr.Value{
+		"b": NewStringValue("two"),
+		"c": NewStringValue("three"),
+		"a": NewStringValue("one"),
+	}
+
+	testCases := map[string]struct {
+		keys             []string
+		elements         map[string]attr.Value
+		expectedKeys     []string
+		expectedElements map[string]attr.Value
+		expectedDiags    diag.Diagnostics
+	}{
+		"explicit-order": {
+			keys:             []string{"c", "a", "b"},
+			elements:         elements,
+			expectedKeys:     []string{"c", "a", "b"},
+			expectedElements: elements,
+		},
+		"empty": {
+			keys:             []string{},
+			elements:         map[string]attr.Value{},
+			expectedKeys:     []string{},
+			expectedElements: map[string]attr.Value{},
+		},
+		"key-without-element": {
+			keys:     []string{"c", "a", "b", "d"},
+			elements: elements,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Ordered Map Key",
+					"While creating an Ordered Map value, a key without an element was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Ordered Map Key: d",
+				),
+			},
+		},
+		"duplicate-key": {
+			keys:     []string{"c", "a", "b", "a"},
+			elements: elements,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Ordered Map Key",
+					"While creating an Ordered Map value, a duplicate key was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Ordered Map Key: a",
+				),
+			},
+		},
+		"element-without-key": {
+			keys:     []string{"c", "a"},
+			elements: elements,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Missing Ordered Map Key",
+					"While creating an Ordered Map value, an element without an ordered key was detected. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Map Key: b",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewOrderedMapValue(StringType{}, testCase.keys, testCase.elements)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diags.HasError() {
+				if !got.IsUnknown() {
+					t.Errorf("expected unknown value, got: %s", got)
+				}
+
+				return
+			}
+
+			if diff := cmp.Diff(got.OrderedKeys(), testCase.expectedKeys); diff != "" {
+				t.Errorf("unexpected keys difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Elements(), testCase.expectedElements); diff != "" {
+				t.Errorf("unexpected elements difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewOrderedMapValue_keysCopied(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"b", "a"}
+	value := NewOrderedMapValueMust(
+		StringType{},
+		keys,
+		map[string]attr.Value{
+			"a": NewStringValue("one"),
+			"b": NewStringValue("two"),
+		},
+	)
+
+	keys[0] = "a"
+	value.OrderedKeys()[1] = "b"
+
+	if diff := cmp.Diff(value.OrderedKeys(), []string{"b", "a"}); diff != "" {
+		t.Errorf("unexpected keys difference: %s", diff)
+	}
+}
+
+func TestOrderedMapValueOrderedKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    OrderedMapValue
+		expected []string
+	}{
+		"known": {
+			input: NewOrderedMapValueMust(
+				StringType{},
+				[]string{"b", "a"},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringValue("two"),
+				},
+			),
+			expected: []string{"b", "a"},
+		},
+		"null": {
+			input:    NewOrderedMapNull(StringType{}),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewOrderedMapUnknown(StringType{}),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.OrderedKeys()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrderedMapValueEqual(t *testing.T) {
+	t.Parallel()
+
+	elements := map[string]attr.Value{
+		"a": NewStringValue("one"),
+		"b": NewStringValue("two"),
+	}
+
+	testCases := map[string]struct {
+		input    OrderedMapValue
+		other    attr.Value
+		expected bool
+	}{
+		"same-order": {
+			input:    NewOrderedMapValueMust(StringType{}, []string{"b", "a"}, elements),
+			other:    NewOrderedMapValueMust(StringType{}, []string{"b", "a"}, elements),
+			expected: true,
+		},
+		"different-order": {
+			input:    NewOrderedMapValueMust(StringType{}, []string{"b", "a"}, elements),
+			other:    NewOrderedMapValueMust(StringType{}, []string{"a", "b"}, elements),
+			expected: true,
+		},
+		"different-elements": {
+			input: NewOrderedMapValueMust(StringType{}, []string{"b", "a"}, elements),
+			other: NewOrderedMapValueMust(
+				StringType{},
+				[]string{"a"},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+				},
+			),
+			expected: false,
+		},
+		"map": {
+			input:    NewOrderedMapValueMust(StringType{}, []string{"b", "a"}, elements),
+			other:    NewMapValueMust(StringType{}, elements),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Equal(testCase.other)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestOrderedMapValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    OrderedMapValue
+		expected string
+	}{
+		"known": {
+			input: NewOrderedMapValueMust(
+				StringType{},
+				[]string{"b", "a"},
+				map[string]attr.Value{
+					"a": NewStringValue("one"),
+					"b": NewStringValue("two"),
+				},
+			),
+			expected: `{"b":"two","a":"one"}`,
+		},
+		"null": {
+			input:    NewOrderedMapNull(StringType{}),
+			expected: "<null>",
+		},
+		"unknown": {
+			input:    NewOrderedMapUnknown(StringType{}),
+			expected: "<unknown>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.String()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestOrderedMapValueType(t *testing.T) {
+	t.Parallel()
+
+	got := NewOrderedMapNull(StringType{}).Type(context.Background())
+	expected := OrderedMapType{MapType: MapType{ElemType: StringType{}}}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
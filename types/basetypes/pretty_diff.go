@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+// PrettyDiff returns a human-readable, indented comparison of the expected
+// and actual values, intended for test failure messages. Each line is
+// prefixed with "-" for expected content which is not in the actual value,
+// "+" for actual content which is not in the expected value, or a space for
+// content which matches. Differing list, set, map, and object values are
+// compared element by element, so only the changed leaves are highlighted.
+// Set elements are matched by equality, regardless of element order.
+//
+// An empty string is returned if the values are equal.
+//
+// The string returned here is not protected by any compatibility guarantees.
+func PrettyDiff(expected, actual attr.Value) string {
+	if expected == nil && actual == nil {
+		return ""
+	}
+
+	if expected != nil && expected.Equal(actual) {
+		return ""
+	}
+
+	var b strings.Builder
+
+	b.WriteString("--- expected\n+++ actual\n")
+
+	prettyDiffValue(&b, 0, "", expected, actual)
+
+	return b.String()
+}
+
+// prettyDiffChild is an element or attribute of the compared values, where
+// a nil value represents the absence of the element or attribute.
+type prettyDiffChild struct {
+	label    string
+	expected attr.Value
+	actual   attr.Value
+}
+
+func prettyDiffValue(b *strings.Builder, depth int, label string, expected, actual attr.Value) {
+	switch {
+	case expected == nil && actual == nil:
+		return
+	case expected == nil:
+		prettyDiffLine(b, "+", depth, label, actual.String())
+
+		return
+	case actual == nil:
+		prettyDiffLine(b, "-", depth, label, expected.String())
+
+		return
+	case expected.Equal(actual):
+		prettyDiffLine(b, " ", depth, label, actual.String())
+
+		return
+	}
+
+	children, open, closing, ok := prettyDiffChildren(expected, actual)
+
+	if !ok {
+		prettyDiffLine(b, "-", depth, label, expected.String())
+		prettyDiffLine(b, "+", depth, label, actual.String())
+
+		return
+	}
+
+	prettyDiffLine(b, " ", depth, label, open)
+
+	for _, child := range children {
+		prettyDiffValue(b, depth+1, child.label, child.expected, child.actual)
+	}
+
+	prettyDiffLine(b, " ", depth, "", closing)
+}
+
+func prettyDiffLine(b *strings.Builder, marker string, depth int, label string, text string) {
+	if label != "" {
+		text = label + ": " + text
+	}
+
+	fmt.Fprintf(b, "%s %s%s\n", marker, strings.Repeat("  ", depth), text)
+}
+
+// prettyDiffChildren returns the elements or attributes of known collection
+// or object values of the same kind, along with the opening and closing
+// strings of the kind. False is returned for all other values.
+func prettyDiffChildren(expected, actual attr.Value) ([]prettyDiffChild, string, string, bool) {
+	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/521
+	ctx := context.Background()
+
+	if expected.IsNull() || expected.IsUnknown() || actual.IsNull() || actual.IsUnknown() {
+		return nil, "", "", false
+	}
+
+	switch expectedValuable := expected.(type) {
+	case ListValuable:
+		actualValuable, ok := actual.(ListValuable)
+
+		if !ok {
+			return nil, "", "", false
+		}
+
+		expectedList, expectedDiags := expectedValuable.ToListValue(ctx)
+		actualList, actualDiags := actualValuable.ToListValue(ctx)
+
+		if expectedDiags.HasError() || actualDiags.HasError() {
+			return nil, "", "", false
+		}
+
+		expectedElems := expectedList.Elements()
+		actualElems := actualList.Elements()
+		children := make([]prettyDiffChild, 0, len(expectedElems))
+
+		for index := 0; index < len(expectedElems) || index < len(actualElems); index++ {
+			child := prettyDiffChild{
+				label: fmt.Sprintf("[%d]", index),
+			}
+
+			if index < len(expectedElems) {
+				child.expected = expectedElems[index]
+			}
+
+			if index < len(actualElems) {
+				child.actual = actualElems[index]
+			}
+
+			children = append(children, child)
+		}
+
+		return children, "[", "]", true
+	case SetValuable:
+		actualValuable, ok := actual.(SetValuable)
+
+		if !ok {
+			return nil, "", "", false
+		}
+
+		expectedSet, expectedDiags := expectedValuable.ToSetValue(ctx)
+		actualSet, actualDiags := actualValuable.ToSetValue(ctx)
+
+		if expectedDiags.HasError() || actualDiags.HasError() {
+			return nil, "", "", false
+		}
+
+		actualElems := actualSet.Elements()
+		matched := make([]bool, len(actualElems))
+		children := make([]prettyDiffChild, 0, len(actualElems))
+
+		for _, expectedElem := range expectedSet.Elements() {
+			child := prettyDiffChild{
+				expected: expectedElem,
+			}
+
+			for index, actualElem := range actualElems {
+				if !matched[index] && expectedElem.Equal(actualElem) {
+					matched[index] = true
+					child.actual = actualElem
+
+					break
+				}
+			}
+
+			children = append(children, child)
+		}
+
+		for index, actualElem := range actualElems {
+			if !matched[index] {
+				children = append(children, prettyDiffChild{actual: actualElem})
+			}
+		}
+
+		return children, "[", "]", true
+	case MapValuable:
+		actualValuable, ok := actual.(MapValuable)
+
+		if !ok {
+			return nil, "", "", false
+		}
+
+		expectedMap, expectedDiags := expectedValuable.ToMapValue(ctx)
+		actualMap, actualDiags := actualValuable.ToMapValue(ctx)
+
+		if expectedDiags.HasError() || actualDiags.HasError() {
+			return nil, "", "", false
+		}
+
+		children := prettyDiffKeyedChildren(expectedMap.Elements(), actualMap.Elements(), func(key string) string {
+			return fmt.Sprintf("%q", key)
+		})
+
+		return children, "{", "}", true
+	case ObjectValuable:
+		actualValuable, ok := actual.(ObjectValuable)
+
+		if !ok {
+			return nil, "", "", false
+		}
+
+		expectedObject, expectedDiags := expectedValuable.ToObjectValue(ctx)
+		actualObject, actualDiags := actualValuable.ToObjectValue(ctx)
+
+		if expectedDiags.HasError() || actualDiags.HasError() {
+			return nil, "", "", false
+		}
+
+		children := prettyDiffKeyedChildren(expectedObject.Attributes(), actualObject.Attributes(), func(name string) string {
+			return name
+		})
+
+		return children, "{", "}", true
+	default:
+		return nil, "", "", false
+	}
+}
+
+// prettyDiffKeyedChildren returns the children of map elements or object
+// attributes, sorted by key.
+func prettyDiffKeyedChildren(expected, actual map[string]attr.Value, label func(string) string) []prettyDiffChild {
+	keys := make([]string, 0, len(expected))
+
+	for key := range expected {
+		keys = append(keys, key)
+	}
+
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	children := make([]prettyDiffChild, 0, len(keys))
+
+	for _, key := range keys {
+		children = append(children, prettyDiffChild{
+			label:    label(key),
+			expected: expected[key],
+			actual:   actual[key],
+		})
+	}
+
+	return children
+}
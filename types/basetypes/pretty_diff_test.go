@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+func TestPrettyDiff(t *testing.T) {
+	t.Parallel()
+
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": StringType{},
+			"port": Int64Type{},
+			"tags": SetType{ElemType: StringType{}},
+		},
+	}
+
+	newObject := func(name string, port int64, tags ...string) attr.Value {
+		tagValues := make([]attr.Value, 0, len(tags))
+
+		for _, tag := range tags {
+			tagValues = append(tagValues, NewStringValue(tag))
+		}
+
+		return NewObjectValueMust(
+			objectType.AttrTypes,
+			map[string]attr.Value{
+				"name": NewStringValue(name),
+				"port": NewInt64Value(port),
+				"tags": NewSetValueMust(StringType{}, tagValues),
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		expected attr.Value
+		actual   attr.Value
+		want     string
+	}{
+		"equal": {
+			expected: NewListValueMust(objectType, []attr.Value{newObject("web", 80, "a")}),
+			actual:   NewListValueMust(objectType, []attr.Value{newObject("web", 80, "a")}),
+			want:     "",
+		},
+		"nested-object-list": {
+			expected: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					newObject("web", 80, "a", "b"),
+					newObject("db", 5432),
+				},
+			),
+			actual: NewListValueMust(
+				objectType,
+				[]attr.Value{
+					newObject("web", 8080, "b", "c"),
+					newObject("db", 5432),
+					newObject("cache", 6379),
+				},
+			),
+			want: `--- expected
++++ actual
+  [
+    [0]: {
+      name: "web"
+-     port: 80
++     port: 8080
+      tags: [
+-       "a"
+        "b"
++       "c"
+      ]
+    }
+    [1]: {"name":"db","port":5432,"tags":[]}
++   [2]: {"name":"cache","port":6379,"tags":[]}
+  ]
+`,
+		},
+		"map": {
+			expected: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"removed": NewStringValue("old"),
+					"same":    NewStringValue("value"),
+				},
+			),
+			actual: NewMapValueMust(
+				StringType{},
+				map[string]attr.Value{
+					"added": NewStringNull(),
+					"same":  NewStringValue("value"),
+				},
+			),
+			want: `--- expected
++++ actual
+  {
++   "added": <null>
+-   "removed": "old"
+    "same": "value"
+  }
+`,
+		},
+		"null-to-known": {
+			expected: NewListNull(StringType{}),
+			actual:   NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			want: `--- expected
++++ actual
+- <null>
++ ["a"]
+`,
+		},
+		"nil-expected": {
+			expected: nil,
+			actual:   NewStringValue("a"),
+			want: `--- expected
++++ actual
++ "a"
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := PrettyDiff(testCase.expected, testCase.actual)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s\n\ngot:\n%s", diff, got)
+			}
+		})
+	}
+}
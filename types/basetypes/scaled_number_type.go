@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ xattr.TypeWithValidate = ScaledNumberType{}
+
+// ScaledNumberType is a NumberType which only permits values with at most
+// Scale decimal places, such as currency amounts. Trailing zeros after the
+// decimal point are not counted. Values are still represented with
+// NumberValue and are never rounded, the scale is only enforced by Validate.
+type ScaledNumberType struct {
+	NumberType
+
+	// Scale is the maximum number of decimal places. A Scale of zero only
+	// permits integers.
+	Scale int
+}
+
+// NewScaledNumberType returns a ScaledNumberType which only permits values
+// with at most `scale` decimal places.
+func NewScaledNumberType(scale int) ScaledNumberType {
+	return ScaledNumberType{
+		Scale: scale,
+	}
+}
+
+// Equal returns true if the given type is also a ScaledNumberType with the
+// same scale.
+func (t ScaledNumberType) Equal(o attr.Type) bool {
+	other, ok := o.(ScaledNumberType)
+
+	if !ok {
+		return false
+	}
+
+	return t.Scale == other.Scale
+}
+
+// String returns a human readable string of the type name.
+func (t ScaledNumberType) String() string {
+	return fmt.Sprintf("basetypes.ScaledNumberType[%d]", t.Scale)
+}
+
+// Validate returns an error diagnostic if a known value has more than Scale
+// decimal places. A negative Scale always returns an error diagnostic.
+func (t ScaledNumberType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if t.Scale < 0 {
+		diags.AddAttributeError(
+			path,
+			"Scaled Number Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Scale %d must not be negative.", t.Scale),
+		)
+
+		return diags
+	}
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Equal(tftypes.Number) {
+		diags.AddAttributeError(
+			path,
+			"Scaled Number Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Number value, received %T with value: %v", in, in),
+		)
+
+		return diags
+	}
+
+	if !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var value *big.Float
+
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Scaled Number Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Cannot convert value to big.Float: %s", err),
+		)
+
+		return diags
+	}
+
+	// The shortest decimal representation never has trailing zeros after
+	// the decimal point, so they are not counted.
+	text := value.Text('f', -1)
+
+	if scale := decimalPlaces(text); scale > t.Scale {
+		diags.AddAttributeError(
+			path,
+			"Value Exceeds Scale",
+			fmt.Sprintf("Value must have at most %d decimal places, got: %s", t.Scale, text),
+		)
+	}
+
+	return diags
+}
+
+// decimalPlaces returns the number of digits after the decimal point of the
+// given decimal number text.
+func decimalPlaces(text string) int {
+	index := strings.IndexByte(text, '.')
+
+	if index < 0 {
+		return 0
+	}
+
+	return len(text) - index - 1
+}
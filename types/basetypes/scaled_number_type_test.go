@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testScaledNumber(t *testing.T, s string) *big.Float {
+	t.Helper()
+
+	f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+
+	if err != nil {
+		t.Fatalf("unable to parse %q: %s", s, err)
+	}
+
+	return f
+}
+
+func TestScaledNumberTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ      ScaledNumberType
+		in       string
+		expected diag.Diagnostics
+	}{
+		"integer": {
+			typ: NewScaledNumberType(2),
+			in:  "12",
+		},
+		"within-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "12.3",
+		},
+		"at-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "12.34",
+		},
+		"exceeds-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "12.345",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Exceeds Scale",
+					"Value must have at most 2 decimal places, got: 12.345",
+				),
+			},
+		},
+		"negative-at-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "-12.34",
+		},
+		"negative-exceeds-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "-0.001",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Exceeds Scale",
+					"Value must have at most 2 decimal places, got: -0.001",
+				),
+			},
+		},
+		"trailing-zeros": {
+			typ: NewScaledNumberType(2),
+			in:  "12.3400000",
+		},
+		"zero-scale-integer": {
+			typ: NewScaledNumberType(0),
+			in:  "100",
+		},
+		"zero-scale-fraction": {
+			typ: NewScaledNumberType(0),
+			in:  "100.5",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Exceeds Scale",
+					"Value must have at most 0 decimal places, got: 100.5",
+				),
+			},
+		},
+		"large-integer": {
+			typ: NewScaledNumberType(0),
+			in:  "123456789012345678901234567890",
+		},
+		"large-integer-exceeds-scale": {
+			typ: NewScaledNumberType(2),
+			in:  "123456789012345678901234567890.125",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Value Exceeds Scale",
+					"Value must have at most 2 decimal places, got: 123456789012345678901234567890.125",
+				),
+			},
+		},
+		"negative-scale": {
+			typ: NewScaledNumberType(-1),
+			in:  "1",
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Scaled Number Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Scale -1 must not be negative.",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			in := tftypes.NewValue(tftypes.Number, testScaledNumber(t, testCase.in))
+
+			got := testCase.typ.Validate(context.Background(), in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestScaledNumberTypeValidate_values(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in       tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"float64": {
+			in: tftypes.NewValue(tftypes.Number, big.NewFloat(1.05)),
+		},
+		"null": {
+			in: tftypes.NewValue(tftypes.Number, nil),
+		},
+		"unknown": {
+			in: tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.String, "1.05"),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Scaled Number Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						`Expected Number value, received tftypes.Value with value: tftypes.String<"1.05">`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewScaledNumberType(2).Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestScaledNumberTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	value := testScaledNumber(t, "12.345")
+
+	got, err := NewScaledNumberType(2).ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.Number, value))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The value is never rounded to the scale.
+	if diff := cmp.Diff(got, NewNumberValue(value)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestScaledNumberTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    attr.Type
+		expected bool
+	}{
+		"same-scale": {
+			input:    NewScaledNumberType(2),
+			expected: true,
+		},
+		"different-scale": {
+			input:    NewScaledNumberType(3),
+			expected: false,
+		},
+		"number": {
+			input:    NumberType{},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewScaledNumberType(2).Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
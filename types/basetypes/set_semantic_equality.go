@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// setElementHasSemanticEquals returns true if the set element value
+// implements one of the *ValuableWithSemanticEquals interfaces, such as
+// StringValuableWithSemanticEquals.
+func setElementHasSemanticEquals(value attr.Value) bool {
+	switch value.(type) {
+	case BoolValuableWithSemanticEquals,
+		Float64ValuableWithSemanticEquals,
+		Int64ValuableWithSemanticEquals,
+		ListValuableWithSemanticEquals,
+		MapValuableWithSemanticEquals,
+		NumberValuableWithSemanticEquals,
+		ObjectValuableWithSemanticEquals,
+		SetValuableWithSemanticEquals,
+		StringValuableWithSemanticEquals:
+		return true
+	default:
+		return false
+	}
+}
+
+// setElementSemanticEquals returns true if the given set element values are
+// semantically equal, using the semantic equality method of `a`. Values
+// which do not implement semantic equality are never semantically equal.
+func setElementSemanticEquals(ctx context.Context, a, b attr.Value) (bool, diag.Diagnostics) {
+	switch a := a.(type) {
+	case BoolValuableWithSemanticEquals:
+		if b, ok := b.(BoolValuable); ok {
+			return a.BoolSemanticEquals(ctx, b)
+		}
+	case Float64ValuableWithSemanticEquals:
+		if b, ok := b.(Float64Valuable); ok {
+			return a.Float64SemanticEquals(ctx, b)
+		}
+	case Int64ValuableWithSemanticEquals:
+		if b, ok := b.(Int64Valuable); ok {
+			return a.Int64SemanticEquals(ctx, b)
+		}
+	case ListValuableWithSemanticEquals:
+		if b, ok := b.(ListValuable); ok {
+			return a.ListSemanticEquals(ctx, b)
+		}
+	case MapValuableWithSemanticEquals:
+		if b, ok := b.(MapValuable); ok {
+			return a.MapSemanticEquals(ctx, b)
+		}
+	case NumberValuableWithSemanticEquals:
+		if b, ok := b.(NumberValuable); ok {
+			return a.NumberSemanticEquals(ctx, b)
+		}
+	case ObjectValuableWithSemanticEquals:
+		if b, ok := b.(ObjectValuable); ok {
+			return a.ObjectSemanticEquals(ctx, b)
+		}
+	case SetValuableWithSemanticEquals:
+		if b, ok := b.(SetValuable); ok {
+			return a.SetSemanticEquals(ctx, b)
+		}
+	case StringValuableWithSemanticEquals:
+		if b, ok := b.(StringValuable); ok {
+			return a.StringSemanticEquals(ctx, b)
+		}
+	}
+
+	return false, nil
+}
+
+// setElementsEqual returns true if the given set elements are duplicates, as
+// determined by SetType Validate. Elements are duplicates if their Terraform
+// values are equal, or if both are non-null and semantically equal, using
+// the semantic equality method of `a`. The element values `a` and `b` may be
+// nil if the element type does not implement semantic equality.
+func setElementsEqual(ctx context.Context, a, b attr.Value, aTerraform, bTerraform tftypes.Value) (bool, diag.Diagnostics) {
+	if aTerraform.Equal(bTerraform) {
+		return true, nil
+	}
+
+	if a == nil || b == nil || aTerraform.IsNull() || bTerraform.IsNull() {
+		return false, nil
+	}
+
+	return setElementSemanticEquals(ctx, a, b)
+}
+
+// setElementIndex finds duplicate set elements, as determined by SetType
+// Validate. Each element is converted to its Terraform value once, when it is
+// added, and elements are grouped into buckets as with SetType Validate, so
+// only elements within the same bucket are compared.
+type setElementIndex struct {
+	// semanticEquals is true if the element type implements semantic
+	// equality, in which case all elements share a single bucket.
+	semanticEquals bool
+
+	// elements are the fully known elements added to the index.
+	elements []attr.Value
+
+	// terraformValues are the Terraform values of elements.
+	terraformValues []tftypes.Value
+
+	// buckets are the indices of elements, by setElementBucketKey.
+	buckets map[string][]int
+}
+
+// newSetElementIndex returns an empty setElementIndex for elements of the
+// given type.
+func newSetElementIndex(ctx context.Context, elementType attr.Type) *setElementIndex {
+	return &setElementIndex{
+		semanticEquals: elementType != nil && setElementHasSemanticEquals(elementType.ValueType(ctx)),
+		buckets:        make(map[string][]int),
+	}
+}
+
+// newSetElementIndexOf returns a setElementIndex for elements of the given
+// type, containing the fully known `elements`, such as the elements of an
+// existing set. The given elements are not compared with each other.
+func newSetElementIndexOf(ctx context.Context, elementType attr.Type, elements []attr.Value) (*setElementIndex, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	index := newSetElementIndex(ctx, elementType)
+
+	for _, element := range elements {
+		elementTerraform, elementDiags := setElementTerraformValue(ctx, element)
+
+		diags.Append(elementDiags...)
+
+		if diags.HasError() {
+			return index, diags
+		}
+
+		if elementTerraform.IsFullyKnown() {
+			index.insert(element, elementTerraform)
+		}
+	}
+
+	return index, diags
+}
+
+// bucketKey returns the bucket of the given element Terraform value.
+func (i *setElementIndex) bucketKey(elemTerraform tftypes.Value) string {
+	if i.semanticEquals {
+		return ""
+	}
+
+	return setElementBucketKey(elemTerraform)
+}
+
+// add adds the given element to the index, if it is fully known and not a
+// duplicate of an element already in the index. It returns false if the
+// element is a duplicate. Elements which are not fully known are never
+// duplicates, so true is always returned for them.
+func (i *setElementIndex) add(ctx context.Context, element attr.Value) (bool, diag.Diagnostics) {
+	elementTerraform, diags := setElementTerraformValue(ctx, element)
+
+	if diags.HasError() || !elementTerraform.IsFullyKnown() {
+		return true, diags
+	}
+
+	contains, containsDiags := i.containsTerraform(ctx, element, elementTerraform)
+
+	diags.Append(containsDiags...)
+
+	if diags.HasError() || contains {
+		return !contains, diags
+	}
+
+	i.insert(element, elementTerraform)
+
+	return true, diags
+}
+
+// insert adds the given fully known element, whose Terraform value is
+// `elementTerraform`, to the index without checking for duplicates.
+func (i *setElementIndex) insert(element attr.Value, elementTerraform tftypes.Value) {
+	key := i.bucketKey(elementTerraform)
+
+	i.buckets[key] = append(i.buckets[key], len(i.elements))
+	i.elements = append(i.elements, element)
+	i.terraformValues = append(i.terraformValues, elementTerraform)
+}
+
+// contains returns true if any element in the index is a duplicate of
+// `candidate`. Elements which are not fully known are never contained.
+func (i *setElementIndex) contains(ctx context.Context, candidate attr.Value) (bool, diag.Diagnostics) {
+	candidateTerraform, diags := setElementTerraformValue(ctx, candidate)
+
+	if diags.HasError() || !candidateTerraform.IsFullyKnown() {
+		return false, diags
+	}
+
+	contains, containsDiags := i.containsTerraform(ctx, candidate, candidateTerraform)
+
+	diags.Append(containsDiags...)
+
+	return contains, diags
+}
+
+// containsTerraform returns true if any element in the index is a duplicate
+// of `candidate`, whose Terraform value is `candidateTerraform`.
+func (i *setElementIndex) containsTerraform(ctx context.Context, candidate attr.Value, candidateTerraform tftypes.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	for _, index := range i.buckets[i.bucketKey(candidateTerraform)] {
+		equal, equalDiags := setElementsEqual(ctx, i.elements[index], candidate, i.terraformValues[index], candidateTerraform)
+
+		diags.Append(equalDiags...)
+
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if equal {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+// setElementTerraformValue returns the Terraform value of the given set
+// element, or an error diagnostic if it cannot be converted.
+func setElementTerraformValue(ctx context.Context, element attr.Value) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementTerraform, err := element.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Set Element Comparison Error",
+			"An unexpected error was encountered trying to compare set elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+	}
+
+	return elementTerraform, diags
+}
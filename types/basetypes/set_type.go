@@ -6,10 +6,13 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -33,6 +36,54 @@ type SetTypable interface {
 // property.
 type SetType struct {
 	ElemType attr.Type
+
+	// AllowNullElements, when enabled, permits null elements in the set.
+	// By default, Validate returns an error diagnostic at the set path for
+	// each null element of a known set received from Terraform, as null
+	// elements are almost always unintended and are not distinguishable from
+	// each other for duplicate detection. Values created by the provider,
+	// such as with the (tfsdk.State).Set method or NewSetValueFrom, may
+	// always contain null elements.
+	AllowNullElements bool
+
+	// DisallowNullElements, when enabled, causes Validate to return an error
+	// diagnostic at the element path, created with path.Path.AtSetValue, for
+	// each null element of a known set, even if AllowNullElements is enabled
+	// or the value was created by the provider.
+	DisallowNullElements bool
+
+	// MaxElementDiagnostics, when greater than zero, limits the number of
+	// element diagnostics returned by Validate. Once the limit is reached,
+	// a single diagnostic summarizing the number of omitted diagnostics is
+	// returned instead of the remaining element diagnostics. By default,
+	// all element diagnostics are returned.
+	MaxElementDiagnostics int
+
+	// ElementValidationConcurrency, when greater than one, causes Validate
+	// to validate elements concurrently with at most this many goroutines,
+	// such as when element type validation performs network requests. When
+	// negative, Validate uses at most runtime.GOMAXPROCS goroutines, such as
+	// for CPU-bound element type validation of large collections.
+	// Diagnostics are returned in the same order as sequential validation.
+	// By default, elements are validated sequentially.
+	ElementValidationConcurrency int
+
+	// MinItems, when greater than zero, causes Validate to return an error
+	// diagnostic if a known set contains fewer elements. By default, the
+	// number of elements is not limited.
+	MinItems int
+
+	// MaxItems, when greater than zero, causes Validate to return an error
+	// diagnostic if a known set contains more elements. By default, the
+	// number of elements is not limited.
+	MaxItems int
+
+	// SortElements, when enabled, causes ValueFromTerraform to order the
+	// elements of the Set by their tftypes.Value String representation,
+	// rather than the order received from Terraform, so the same set of
+	// elements always results in the same Elements order. This does not
+	// affect Equal or the Terraform representation of the Set.
+	SortElements bool
 }
 
 // ElementType returns the attr.Type elements will be created from.
@@ -43,7 +94,16 @@ func (st SetType) ElementType() attr.Type {
 // WithElementType returns a SetType that is identical to `l`, but with the
 // element type set to `typ`.
 func (st SetType) WithElementType(typ attr.Type) attr.TypeWithElementType {
-	return SetType{ElemType: typ}
+	return SetType{
+		ElemType:                     typ,
+		AllowNullElements:            st.AllowNullElements,
+		DisallowNullElements:         st.DisallowNullElements,
+		MaxElementDiagnostics:        st.MaxElementDiagnostics,
+		ElementValidationConcurrency: st.ElementValidationConcurrency,
+		MinItems:                     st.MinItems,
+		MaxItems:                     st.MaxItems,
+		SortElements:                 st.SortElements,
+	}
 }
 
 // TerraformType returns the tftypes.Type that should be used to
@@ -57,6 +117,16 @@ func (st SetType) TerraformType(ctx context.Context) tftypes.Type {
 	}
 }
 
+// acceptsTerraformType returns true if the given type is a Set whose
+// element type is accepted by ElemType, such as an object type with
+// additional attributes when ElemType is an ObjectType with
+// IgnoreUndefinedAttributes enabled.
+func (st SetType) acceptsTerraformType(ctx context.Context, typ tftypes.Type) bool {
+	setType, ok := typ.(tftypes.Set)
+
+	return ok && acceptsTerraformType(ctx, st.ElemType, setType.ElementType)
+}
+
 // ValueFromTerraform returns an attr.Value given a tftypes.Value.
 // This is meant to convert the tftypes.Value into a more convenient Go
 // type for the provider to consume the data with.
@@ -64,7 +134,7 @@ func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	if in.Type() == nil {
 		return NewSetNull(st.ElemType), nil
 	}
-	if !in.Type().Equal(st.TerraformType(ctx)) {
+	if !st.acceptsTerraformType(ctx, in.Type()) {
 		return nil, fmt.Errorf("can't use %s as value of Set with ElementType %T, can only use %s values", in.String(), st.ElemType, st.ElemType.TerraformType(ctx).String())
 	}
 	if !in.IsKnown() {
@@ -78,6 +148,9 @@ func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	if err != nil {
 		return nil, err
 	}
+	if st.SortElements {
+		val = sortedTerraformElements(val)
+	}
 	elems := make([]attr.Value, 0, len(val))
 	for _, elem := range val {
 		av, err := st.ElemType.ValueFromTerraform(ctx, elem)
@@ -91,7 +164,33 @@ func (st SetType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (att
 	return NewSetValueMust(st.ElemType, elems), nil
 }
 
+// sortedTerraformElements returns a copy of the given elements ordered by
+// their String representation. The elements are copied, as they may be shared
+// with the original tftypes.Value.
+func sortedTerraformElements(elems []tftypes.Value) []tftypes.Value {
+	keys := make([]string, len(elems))
+	sorted := make([]int, len(elems))
+
+	for idx, elem := range elems {
+		keys[idx] = elem.String()
+		sorted[idx] = idx
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keys[sorted[i]] < keys[sorted[j]]
+	})
+
+	result := make([]tftypes.Value, 0, len(elems))
+
+	for _, idx := range sorted {
+		result = append(result, elems[idx])
+	}
+
+	return result
+}
+
 // Equal returns true if `o` is also a SetType and has the same ElemType.
+// Validation options, such as AllowNullElements, are not considered.
 func (st SetType) Equal(o attr.Type) bool {
 	if st.ElemType == nil {
 		return false
@@ -119,7 +218,20 @@ func (st SetType) String() string {
 }
 
 // Validate implements type validation. This type requires all elements to be
-// unique.
+// unique. Elements are compared with tftypes.Value.Equal, unless the element
+// value type implements semantic equality, such as
+// StringValuableWithSemanticEquals, in which case semantically equal known
+// elements are also duplicates. Duplicate element diagnostics are at the path
+// of the later element, created with path.Path.AtSetValue. Unless
+// AllowNullElements is enabled or the value was created by the provider, all
+// elements must also be non-null, while unknown elements are permitted. If
+// MinItems or MaxItems are set, the number of elements of a known set is also
+// validated.
+//
+// Diagnostics are returned in a consistent order: null element and element
+// type validation diagnostics, in configuration order, then duplicate element
+// diagnostics, ordered by the configuration order of the earlier and then the
+// later element of each duplicate pair, then element count diagnostics.
 func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -152,52 +264,214 @@ func (st SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path
 		return diags
 	}
 
-	validatableType, isValidatable := st.ElemType.(xattr.TypeWithValidate)
+	isValidatable := hasValidation(st.ElemType)
+	rejectNullElements := st.DisallowNullElements || (!st.AllowNullElements && !fwcontext.IsProviderValue(ctx))
 
 	// Attempting to use map[tftypes.Value]struct{} for duplicate detection yields:
 	//   panic: runtime error: hash of unhashable type tftypes.primitive
-	// Instead, use for loops.
-	for indexOuter, elemOuter := range elems {
+	// Instead, group fully known elements into buckets by a string key and
+	// only compare elements for equality within the same bucket. Element
+	// values with semantic equality may be equal regardless of their string
+	// representation, so those elements share a single bucket.
+	semanticEquals := st.ElemType != nil && setElementHasSemanticEquals(st.ElemType.ValueType(ctx))
+	bucketKey := setElementBucketKey
+
+	if semanticEquals {
+		bucketKey = func(tftypes.Value) string { return "" }
+	}
+
+	buckets := setElementBuckets(elems, bucketKey)
+
+	// Convert elements for diagnostic paths before validating them, so
+	// element validation can be performed concurrently. Elements after a
+	// conversion error are not validated.
+	elemValues := make([]attr.Value, len(elems))
+	validateCount := len(elems)
+	var convertErr error
+
+	for index, elem := range elems {
 		// Only evaluate fully known values for duplicates and validation.
-		if !elemOuter.IsFullyKnown() {
+		if !elem.IsFullyKnown() {
 			continue
 		}
 
-		// Validate the element first
-		if isValidatable {
-			elemValue, err := st.ElemType.ValueFromTerraform(ctx, elemOuter)
-			if err != nil {
-				diags.AddAttributeError(
-					path,
-					"Set Type Validation Error",
-					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
-				)
-				return diags
+		if !isValidatable && !semanticEquals {
+			continue
+		}
+
+		elemValue, err := st.ElemType.ValueFromTerraform(ctx, elem)
+		if err != nil {
+			validateCount = index
+			convertErr = err
+			break
+		}
+
+		elemValues[index] = elemValue
+	}
+
+	var elemDiags []diag.Diagnostics
+
+	if isValidatable {
+		elemDiags = validateElements(st.ElementValidationConcurrency, validateCount, func(index int) diag.Diagnostics {
+			if elemValues[index] == nil {
+				return nil
 			}
-			diags = append(diags, validatableType.Validate(ctx, elemOuter, path.AtSetValue(elemValue))...)
+			return fwtype.Validate(ctx, st.ElemType, elems[index], path.AtSetValue(elemValues[index]))
+		})
+	}
+
+	// All element diagnostics are returned first, in element order, then
+	// all duplicate diagnostics, in element pair order.
+	for index, elem := range elems {
+		// Only evaluate fully known values for duplicates and validation.
+		if !elem.IsFullyKnown() {
+			continue
+		}
+
+		if convertErr != nil && index == validateCount {
+			diags.AddAttributeError(
+				path,
+				"Set Type Validation Error",
+				"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+convertErr.Error(),
+			)
+			return diags
+		}
+
+		if rejectNullElements && elem.IsNull() {
+			nullPath := path
+
+			if st.DisallowNullElements {
+				nullPath = st.elementPath(ctx, path, elem, elemValues[index])
+			}
+
+			diags.AddAttributeError(
+				nullPath,
+				"Null Set Element",
+				fmt.Sprintf("This attribute contains a null element at configuration order position %d, which is not permitted.", index+1),
+			)
+		}
+
+		if isValidatable {
+			diags = append(diags, elemDiags[index]...)
+		}
+	}
+
+	for indexOuter, elemOuter := range elems {
+		if !elemOuter.IsFullyKnown() {
+			continue
 		}
 
-		// Then check for duplicates
-		for indexInner := indexOuter + 1; indexInner < len(elems); indexInner++ {
+		for _, indexInner := range buckets[bucketKey(elemOuter)] {
+			if indexInner <= indexOuter {
+				continue
+			}
+
 			elemInner := elems[indexInner]
 
-			if !elemInner.Equal(elemOuter) {
+			equal, equalDiags := setElementsEqual(ctx, elemValues[indexOuter], elemValues[indexInner], elemOuter, elemInner)
+
+			diags.Append(equalDiags...)
+
+			if !equal {
 				continue
 			}
 
-			// TODO: Point at element attr.Value when Validate method is converted to attr.Value
-			// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/172
 			diags.AddAttributeError(
-				path,
+				st.elementPath(ctx, path, elemInner, elemValues[indexInner]),
 				"Duplicate Set Element",
-				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
+				fmt.Sprintf("This attribute contains duplicate values of: %s\n\n"+
+					"The elements at configuration order positions %d and %d are equal.", diag.TruncateValue(ctx, elemInner.String()), indexOuter+1, indexInner+1),
 			)
 		}
 	}
 
+	diags = limitElementDiagnostics(path, diags, st.MaxElementDiagnostics)
+
+	// The element count is checked after element and duplicate diagnostics,
+	// so it is never omitted by MaxElementDiagnostics.
+	if d := elementCountDiagnostic(len(elems), st.MinItems, st.MaxItems); d != nil {
+		diags.Append(diag.WithPath(path, d))
+	}
+
 	return diags
 }
 
+// elementPath returns the path of the given set element, using the element
+// value if it was already converted from the Terraform value. If the element
+// is not fully known or cannot be converted, such as without an element type,
+// the set path is returned.
+func (st SetType) elementPath(ctx context.Context, setPath path.Path, elem tftypes.Value, elemValue attr.Value) path.Path {
+	if !elem.IsFullyKnown() || (elemValue == nil && st.ElemType == nil) {
+		return setPath
+	}
+
+	if elemValue == nil {
+		var err error
+
+		elemValue, err = st.ElemType.ValueFromTerraform(ctx, elem)
+
+		if err != nil {
+			return setPath
+		}
+	}
+
+	return setPath.AtSetValue(elemValue)
+}
+
+// ValidatablePaths returns the paths, relative to the given value, which
+// Validate would validate with the element type, without running validation.
+// Paths within elements are included when the element type also implements
+// ValidatablePaths, such as nested collections. If the element type has no
+// validation, such as an ObjectType without RequiredTogether groups, or the
+// value is null or unknown, no paths are returned. Elements which are not
+// fully known are not validated, so are not included.
+func (st SetType) ValidatablePaths(ctx context.Context, in tftypes.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil || !in.IsKnown() || in.IsNull() {
+		return nil, diags
+	}
+
+	if !hasValidation(st.ElemType) {
+		return nil, diags
+	}
+
+	var elems []tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddError(
+			"Set Type Validation Error",
+			"An unexpected error was encountered trying to determine the validatable paths of a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	var paths path.Paths
+
+	for _, elem := range elems {
+		if !elem.IsFullyKnown() {
+			continue
+		}
+
+		elemValue, err := st.ElemType.ValueFromTerraform(ctx, elem)
+
+		if err != nil {
+			diags.AddError(
+				"Set Type Validation Error",
+				"An unexpected error was encountered trying to determine the validatable paths of a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, diags
+		}
+
+		elemPaths, elemDiags := elementValidatablePaths(ctx, st.ElemType, elem, path.Empty().AtSetValue(elemValue))
+
+		diags.Append(elemDiags...)
+		paths = append(paths, elemPaths...)
+	}
+
+	return paths, diags
+}
+
 // ValueType returns the Value type.
 func (st SetType) ValueType(_ context.Context) attr.Value {
 	return SetValue{
@@ -209,3 +483,99 @@ func (st SetType) ValueType(_ context.Context) attr.Value {
 func (st SetType) ValueFromSet(_ context.Context, set SetValue) (SetValuable, diag.Diagnostics) {
 	return set, nil
 }
+
+// CopyValue returns a deep copy of the given known Set value, with fresh
+// element storage and the same state redacted paths. The copy is converted
+// with the ValueFromSet method of the value type, so custom types are
+// preserved. Elements are copied with attr.Copy.
+func (st SetType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(SetValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	set, diags := valuable.ToSetValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to SetValue: %v", diags)
+	}
+
+	elements, err := copySliceValues(ctx, set.elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	set.elements = elements
+	set.stateRedactedPaths = copyPaths(set.stateRedactedPaths)
+
+	typable, ok := v.Type(ctx).(SetTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromSet(ctx, set)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting SetValue to SetValuable: %v", diags)
+	}
+
+	return result, nil
+}
+
+// setElementBuckets groups the indices of fully known set elements by the
+// given key function, preserving element order within each bucket.
+func setElementBuckets(elems []tftypes.Value, bucketKey func(tftypes.Value) string) map[string][]int {
+	buckets := make(map[string][]int, len(elems))
+
+	for index, elem := range elems {
+		if !elem.IsFullyKnown() {
+			continue
+		}
+
+		key := bucketKey(elem)
+		buckets[key] = append(buckets[key], index)
+	}
+
+	return buckets
+}
+
+// setElementBucketKey returns a key which is always the same for equal set
+// elements. The string representation of a value is used, unless the value
+// type contains a set, whose string representation depends on element order.
+// Those values share a single bucket and are compared with Equal.
+func setElementBucketKey(elem tftypes.Value) string {
+	if tftypeContainsSet(elem.Type()) {
+		return ""
+	}
+
+	return elem.String()
+}
+
+// tftypeContainsSet returns true if the type is or contains a set type.
+func tftypeContainsSet(typ tftypes.Type) bool {
+	switch t := typ.(type) {
+	case tftypes.Set:
+		return true
+	case tftypes.List:
+		return tftypeContainsSet(t.ElementType)
+	case tftypes.Map:
+		return tftypeContainsSet(t.ElementType)
+	case tftypes.Object:
+		for _, attrType := range t.AttributeTypes {
+			if tftypeContainsSet(attrType) {
+				return true
+			}
+		}
+	case tftypes.Tuple:
+		for _, elemType := range t.ElementTypes {
+			if tftypeContainsSet(elemType) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
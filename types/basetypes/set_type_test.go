@@ -5,10 +5,13 @@ package basetypes
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -118,6 +121,51 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 				},
 			),
 		},
+		"set-of-objects-ignore-undefined-attributes": {
+			receiver: SetType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+					IgnoreUndefinedAttributes: true,
+				},
+			},
+			input: tftypes.NewValue(tftypes.Set{
+				ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				},
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"a": tftypes.String,
+						"b": tftypes.Bool,
+					},
+				}, map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "hello"),
+					"b": tftypes.NewValue(tftypes.Bool, true),
+				}),
+			}),
+			expected: NewSetValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"a": StringType{},
+					},
+				},
+				[]attr.Value{
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"a": StringType{},
+						},
+						map[string]attr.Value{
+							"a": NewStringValue("hello"),
+						},
+					),
+				},
+			),
+		},
 		"unknown-set": {
 			receiver: SetType{
 				ElemType: StringType{},
@@ -231,6 +279,85 @@ func TestSetTypeValueFromTerraform(t *testing.T) {
 	}
 }
 
+func TestSetTypeValueFromTerraformSortElements(t *testing.T) {
+	t.Parallel()
+
+	setType := tftypes.Set{ElementType: tftypes.String}
+	first := tftypes.NewValue(setType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "c"),
+		tftypes.NewValue(tftypes.String, "a"),
+		tftypes.NewValue(tftypes.String, nil),
+		tftypes.NewValue(tftypes.String, "b"),
+	})
+	second := tftypes.NewValue(setType, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "b"),
+		tftypes.NewValue(tftypes.String, nil),
+		tftypes.NewValue(tftypes.String, "c"),
+		tftypes.NewValue(tftypes.String, "a"),
+	})
+
+	receiver := SetType{
+		ElemType:     StringType{},
+		SortElements: true,
+	}
+
+	firstValue, err := receiver.ValueFromTerraform(context.Background(), first)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	secondValue, err := receiver.ValueFromTerraform(context.Background(), second)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Elements are ordered by tftypes.Value String, such as
+	// tftypes.String<"a">, so null elements are last.
+	expectedElements := []attr.Value{
+		NewStringValue("a"),
+		NewStringValue("b"),
+		NewStringValue("c"),
+		NewStringNull(),
+	}
+
+	//nolint:forcetypeassert // ValueFromTerraform always returns SetValue
+	if diff := cmp.Diff(firstValue.(SetValue).Elements(), expectedElements); diff != "" {
+		t.Errorf("unexpected first elements difference: %s", diff)
+	}
+
+	//nolint:forcetypeassert // ValueFromTerraform always returns SetValue
+	if diff := cmp.Diff(secondValue.(SetValue).Elements(), expectedElements); diff != "" {
+		t.Errorf("unexpected second elements difference: %s", diff)
+	}
+
+	if !firstValue.Equal(secondValue) {
+		t.Errorf("expected converted sets to be equal")
+	}
+
+	got, err := firstValue.ToTerraformValue(context.Background())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.Equal(first) {
+		t.Errorf("expected Terraform value %s, got %s", first, got)
+	}
+
+	// The original elements must not be reordered by sorting.
+	var firstElements []tftypes.Value
+
+	if err := first.As(&firstElements); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !firstElements[0].Equal(tftypes.NewValue(tftypes.String, "c")) {
+		t.Errorf("expected original elements to be unchanged, got %s", firstElements)
+	}
+}
+
 func TestSetTypeEqual(t *testing.T) {
 	t.Parallel()
 
@@ -280,3 +407,32 @@ func TestSetTypeEqual(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkSetTypeValidateDuplicates10000(b *testing.B) {
+	elements := make([]tftypes.Value, 0, 10000)
+
+	// Every element is duplicated once to also exercise the equality
+	// comparisons within each duplicate detection bucket.
+	for idx := 0; idx < 10000; idx++ {
+		elements = append(elements, tftypes.NewValue(tftypes.String, strconv.Itoa(idx/2)))
+	}
+
+	var diags diag.Diagnostics
+	ctx := context.Background()
+	in := tftypes.NewValue(
+		tftypes.Set{
+			ElementType: tftypes.String,
+		},
+		elements,
+	)
+	path := path.Root("test")
+	set := SetType{}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		diags = set.Validate(ctx, in, path)
+	}
+
+	benchDiags = diags
+}
@@ -6,6 +6,7 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -97,6 +98,8 @@ func NewSetValue(elementType attr.Type, elements []attr.Value) (SetValue, diag.D
 // The elements must be a slice which can convert into the given element type.
 // Access the value via the Set type Elements or ElementsAs methods.
 func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (SetValue, diag.Diagnostics) {
+	// Null elements are permitted, as with NewSetValue, since the value is
+	// created by the provider rather than validated as configuration.
 	attrValue, diags := reflect.FromValue(
 		ctx,
 		SetType{ElemType: elementType},
@@ -122,6 +125,42 @@ func NewSetValueFrom(ctx context.Context, elementType attr.Type, elements any) (
 	return set, diags
 }
 
+// NewSetValueDeduped creates a Set with a known value, removing duplicate
+// elements instead of leaving them to be reported by SetType Validate. Elements
+// are compared as with that duplicate detection, including semantic equality,
+// using the same element comparison as the Set type Contains method. The
+// first occurrence of each element is kept, in its original order, and
+// elements that are not fully known are always kept.
+// Access the value via the Set type Elements or ElementsAs methods.
+func NewSetValueDeduped(ctx context.Context, elementType attr.Type, elements []attr.Value) (SetValue, diag.Diagnostics) {
+	set, diags := NewSetValue(elementType, elements)
+
+	if diags.HasError() {
+		return set, diags
+	}
+
+	deduped := make([]attr.Value, 0, len(elements))
+	index := newSetElementIndex(ctx, elementType)
+
+	for _, element := range elements {
+		added, addDiags := index.add(ctx, element)
+
+		diags.Append(addDiags...)
+
+		if addDiags.HasError() {
+			return NewSetUnknown(elementType), diags
+		}
+
+		if added {
+			deduped = append(deduped, element)
+		}
+	}
+
+	set.elements = deduped
+
+	return set, diags
+}
+
 // NewSetValueMust creates a Set with a known value, converting any diagnostics
 // into a panic at runtime. Access the value via the Set
 // type Elements or ElementsAs methods.
@@ -162,6 +201,10 @@ type SetValue struct {
 	// state represents whether the value is null, unknown, or known. The
 	// zero-value is null.
 	state attr.ValueState
+
+	// stateRedactedPaths are element-relative paths which are replaced with
+	// null values when the Set is written to Terraform state.
+	stateRedactedPaths []path.Path
 }
 
 // Elements returns a copy of the collection of elements for the Set.
@@ -173,6 +216,18 @@ func (s SetValue) Elements() []attr.Value {
 	return result
 }
 
+// ElementValues returns a copy of the collection of elements for the Set.
+// It is equivalent to Elements and implements CollectionValue.
+func (s SetValue) ElementValues() []attr.Value {
+	return s.Elements()
+}
+
+// Len returns the number of elements in the Set. Null and unknown Sets
+// return zero.
+func (s SetValue) Len() int {
+	return len(s.elements)
+}
+
 // ElementsAs populates `target` with the elements of the SetValue, throwing an
 // error if the elements cannot be stored in `target`.
 func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhandled bool) diag.Diagnostics {
@@ -193,6 +248,35 @@ func (s SetValue) ElementsAs(ctx context.Context, target interface{}, allowUnhan
 	}, path.Empty())
 }
 
+// ElementsAsBounded populates `target` with the elements of the Set, as with
+// ElementsAs, then returns an error diagnostic if the number of elements is
+// outside the inclusive range of `minItems` to `maxItems`. A `minItems` or
+// `maxItems` of zero or less is not checked. The element count of null and
+// unknown Sets is not checked.
+func (s SetValue) ElementsAsBounded(ctx context.Context, target interface{}, allowUnhandled bool, minItems, maxItems int) diag.Diagnostics {
+	diags := s.ElementsAs(ctx, target, allowUnhandled)
+
+	if diags.HasError() || s.state != attr.ValueStateKnown {
+		return diags
+	}
+
+	if d := elementCountDiagnostic(len(s.elements), minItems, maxItems); d != nil {
+		diags.Append(d)
+	}
+
+	return diags
+}
+
+// StringSlice returns the elements of the Set as Go strings without using
+// reflection, which is faster than ElementsAs for large sets. The element
+// type must be StringType and every element must be known and not null,
+// otherwise an error diagnostic is returned and ElementsAs should be used
+// instead. A null Set returns a nil slice and an unknown Set returns an
+// error diagnostic.
+func (s SetValue) StringSlice(_ context.Context) ([]string, diag.Diagnostics) {
+	return stringSliceFromElements("set", s.elementType, s.state, s.elements)
+}
+
 // ElementType returns the element type for the Set.
 func (s SetValue) ElementType(_ context.Context) attr.Type {
 	return s.elementType
@@ -218,6 +302,12 @@ func (s SetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 				return tftypes.NewValue(setType, tftypes.UnknownValue), err
 			}
 
+			val, err = stateRedactElement(ctx, val, s.stateRedactedPaths)
+
+			if err != nil {
+				return tftypes.NewValue(setType, tftypes.UnknownValue), err
+			}
+
 			vals = append(vals, val)
 		}
 
@@ -238,6 +328,11 @@ func (s SetValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
 // Equal returns true if the given attr.Value is also a SetValue, has the
 // same element type, same value state, and contains exactly the element values
 // as defined by the Equal method of the element type.
+//
+// The comparison is independent of element order and uses multiset
+// semantics: each element is matched to exactly one equal element of the
+// other Set, so Sets containing repeated elements are only equal when each
+// element is repeated the same number of times in both Sets.
 func (s SetValue) Equal(o attr.Value) bool {
 	other, ok := o.(SetValue)
 
@@ -261,8 +356,23 @@ func (s SetValue) Equal(o attr.Value) bool {
 		return false
 	}
 
+	matched := make([]bool, len(other.elements))
+
 	for _, elem := range s.elements {
-		if !other.contains(elem) {
+		found := false
+
+		for index, otherElem := range other.elements {
+			if matched[index] || !elem.Equal(otherElem) {
+				continue
+			}
+
+			matched[index] = true
+			found = true
+
+			break
+		}
+
+		if !found {
 			return false
 		}
 	}
@@ -318,7 +428,500 @@ func (s SetValue) String() string {
 	return res.String()
 }
 
+// StringSorted returns a human-readable representation of the Set value,
+// like String, with the elements in sorted order of their string
+// representations. Elements which are themselves sets are also rendered with
+// StringSorted. The result is the same regardless of the order in which
+// elements were added, which makes it suitable for comparison in tests. This
+// does not affect the Set value or its Terraform representation.
+func (s SetValue) StringSorted() string {
+	if s.IsUnknown() {
+		return attr.UnknownValueString
+	}
+
+	if s.IsNull() {
+		return attr.NullValueString
+	}
+
+	elements := make([]string, 0, len(s.elements))
+
+	for _, e := range s.elements {
+		if set, ok := e.(SetValue); ok {
+			elements = append(elements, set.StringSorted())
+
+			continue
+		}
+
+		elements = append(elements, e.String())
+	}
+
+	sort.Strings(elements)
+
+	return "[" + strings.Join(elements, ",") + "]"
+}
+
 // ToSetValue returns the Set.
 func (s SetValue) ToSetValue(context.Context) (SetValue, diag.Diagnostics) {
 	return s, nil
 }
+
+// Min returns the minimum known element of the Set as determined by the given
+// less function, which should report whether `a` sorts before `b`, and true.
+// Null and unknown elements are not considered. If the Set is null, unknown,
+// or has no known elements, nil and false are returned.
+func (s SetValue) Min(less func(a, b attr.Value) bool) (attr.Value, bool) {
+	return s.extreme(less)
+}
+
+// Max returns the maximum known element of the Set as determined by the given
+// less function, which should report whether `a` sorts before `b`, and true.
+// Null and unknown elements are not considered. If the Set is null, unknown,
+// or has no known elements, nil and false are returned.
+func (s SetValue) Max(less func(a, b attr.Value) bool) (attr.Value, bool) {
+	return s.extreme(func(a, b attr.Value) bool {
+		return less(b, a)
+	})
+}
+
+// extreme returns the first known element which no other known element sorts
+// before according to less.
+func (s SetValue) extreme(less func(a, b attr.Value) bool) (attr.Value, bool) {
+	if s.state != attr.ValueStateKnown {
+		return nil, false
+	}
+
+	var result attr.Value
+
+	for _, elem := range s.elements {
+		if elem.IsNull() || elem.IsUnknown() {
+			continue
+		}
+
+		if result == nil || less(elem, result) {
+			result = elem
+		}
+	}
+
+	return result, result != nil
+}
+
+// WithStateRedactedPaths returns a copy of the Set which, when written to
+// Terraform state, replaces the values at the given element-relative paths
+// with null values. Refer to newStateRedactedPaths for details.
+func (s SetValue) WithStateRedactedPaths(elementPaths ...path.Path) SetValue {
+	result := s
+	result.stateRedactedPaths = newStateRedactedPaths(elementPaths)
+
+	return result
+}
+
+// ToListDedupByAttribute returns a List of the object elements of the Set,
+// ordered by the value of the `keyAttr` attribute of each element. String
+// attributes are ordered by their string value, while other attribute types
+// are ordered by their String method representation.
+//
+// Sets only prevent duplicate elements when every attribute is equal, so
+// this method returns an error diagnostic when two elements share the same
+// `keyAttr` attribute value. The element type must be an object type
+// containing the attribute, and the attribute of every element must be known
+// and non-null. Null and unknown Sets are returned as null and unknown Lists.
+func (s SetValue) ToListDedupByAttribute(ctx context.Context, keyAttr string) (ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elementType, ok := s.elementType.(attr.TypeWithAttributeTypes)
+
+	if !ok {
+		diags.AddError(
+			"Set To List Conversion Error",
+			"An unexpected error was encountered trying to convert set elements into a list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Set element type must be an object type, got: %s", s.elementType),
+		)
+
+		return NewListUnknown(s.elementType), diags
+	}
+
+	if _, ok := elementType.AttributeTypes()[keyAttr]; !ok {
+		diags.AddError(
+			"Set To List Conversion Error",
+			"An unexpected error was encountered trying to convert set elements into a list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Set element type %s does not contain attribute: %s", s.elementType, keyAttr),
+		)
+
+		return NewListUnknown(s.elementType), diags
+	}
+
+	switch s.state {
+	case attr.ValueStateNull:
+		return NewListNull(s.elementType), diags
+	case attr.ValueStateUnknown:
+		return NewListUnknown(s.elementType), diags
+	}
+
+	type keyedElement struct {
+		key     string
+		element attr.Value
+	}
+
+	keyedElements := make([]keyedElement, 0, len(s.elements))
+	seen := make(map[string]attr.Value, len(s.elements))
+
+	for _, element := range s.elements {
+		objectValuable, ok := element.(ObjectValuable)
+
+		if !ok {
+			diags.AddError(
+				"Set To List Conversion Error",
+				"An unexpected error was encountered trying to convert set elements into a list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("Set element is not an object value, got: %T", element),
+			)
+
+			continue
+		}
+
+		object, objectDiags := objectValuable.ToObjectValue(ctx)
+
+		diags.Append(objectDiags...)
+
+		if objectDiags.HasError() {
+			continue
+		}
+
+		keyValue := object.attributes[keyAttr]
+
+		if object.IsNull() || object.IsUnknown() || keyValue == nil || keyValue.IsNull() || keyValue.IsUnknown() {
+			diags.AddError(
+				"Set To List Conversion Error",
+				fmt.Sprintf("Set element attribute %q must be known and non-null to identify the element, got element: %s", keyAttr, element),
+			)
+
+			continue
+		}
+
+		key := keyValue.String()
+
+		if stringValuable, ok := keyValue.(StringValuable); ok {
+			stringValue, stringDiags := stringValuable.ToStringValue(ctx)
+
+			diags.Append(stringDiags...)
+
+			if stringDiags.HasError() {
+				continue
+			}
+
+			key = stringValue.ValueString()
+		}
+
+		if existing, ok := seen[key]; ok {
+			diags.AddError(
+				"Duplicate Set Element Identity",
+				fmt.Sprintf("This attribute contains multiple elements with the same %q attribute value of %s: %s and %s", keyAttr, diag.TruncateValue(ctx, keyValue.String()), diag.TruncateValue(ctx, existing.String()), diag.TruncateValue(ctx, element.String())),
+			)
+
+			continue
+		}
+
+		seen[key] = element
+		keyedElements = append(keyedElements, keyedElement{key: key, element: element})
+	}
+
+	if diags.HasError() {
+		return NewListUnknown(s.elementType), diags
+	}
+
+	sort.Slice(keyedElements, func(i, j int) bool {
+		return keyedElements[i].key < keyedElements[j].key
+	})
+
+	elements := make([]attr.Value, 0, len(keyedElements))
+
+	for _, keyedElement := range keyedElements {
+		elements = append(elements, keyedElement.element)
+	}
+
+	list, listDiags := NewListValue(s.elementType, elements)
+
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+// Contains returns true if the Set contains an element equal to `candidate`,
+// using the same element comparison as the duplicate element detection of
+// SetType Validate. Elements are equal if their Terraform values are equal,
+// or if the element value type implements semantic equality, such as
+// StringValuableWithSemanticEquals, and the known elements are semantically
+// equal. Only fully known elements are compared, so a candidate that is not
+// fully known is never contained.
+//
+// An error diagnostic is returned if the Set is null or unknown, or if the
+// `candidate` type does not match the element type of the Set.
+func (s SetValue) Contains(ctx context.Context, candidate attr.Value) (bool, diag.Diagnostics) {
+	diags := s.validateKnown("check set membership")
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if candidate == nil || !s.elementType.Equal(candidate.Type(ctx)) {
+		var candidateType attr.Type
+
+		if candidate != nil {
+			candidateType = candidate.Type(ctx)
+		}
+
+		diags.AddError(
+			"Set Element Type Mismatch",
+			"An unexpected error was encountered trying to check set membership. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Set Element Type: %s\n", s.elementType)+
+				fmt.Sprintf("Candidate Type: %s", candidateType),
+		)
+
+		return false, diags
+	}
+
+	if !IsFullyKnown(ctx, candidate) {
+		return false, diags
+	}
+
+	index, indexDiags := newSetElementIndexOf(ctx, s.elementType, s.elements)
+
+	diags.Append(indexDiags...)
+
+	if indexDiags.HasError() {
+		return false, diags
+	}
+
+	contains, containsDiags := index.contains(ctx, candidate)
+
+	diags.Append(containsDiags...)
+
+	return contains, diags
+}
+
+// Intersect returns a new Set containing the elements of the Set which are
+// also contained in `other`, using the same element comparison as Contains.
+//
+// An error diagnostic is returned if either Set is null or unknown, or if the
+// element types of the Sets do not match.
+func (s SetValue) Intersect(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	diags.Append(s.validateKnown("intersect sets")...)
+	diags.Append(other.validateKnown("intersect sets")...)
+
+	if diags.HasError() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	diags.Append(s.validateElementType(other, "intersect sets")...)
+
+	if diags.HasError() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	elements := make([]attr.Value, 0)
+
+	otherIndex, otherIndexDiags := newSetElementIndexOf(ctx, other.elementType, other.elements)
+
+	diags.Append(otherIndexDiags...)
+
+	if otherIndexDiags.HasError() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	for _, elem := range s.elements {
+		contains, containsDiags := otherIndex.contains(ctx, elem)
+
+		diags.Append(containsDiags...)
+
+		if containsDiags.HasError() {
+			return NewSetUnknown(s.elementType), diags
+		}
+
+		if contains {
+			elements = append(elements, elem)
+		}
+	}
+
+	set, setDiags := NewSetValue(s.elementType, elements)
+	set.stateRedactedPaths = s.stateRedactedPaths
+
+	diags.Append(setDiags...)
+
+	return set, diags
+}
+
+// Union returns a new Set containing the elements of the Set and the elements
+// of `other`, where elements of `other` equal to an element already in the
+// result are collapsed, using the same element comparison as Contains.
+//
+// If either Set is unknown, an unknown Set is returned. An error diagnostic
+// is returned if either Set is null, or if the element types of the Sets do
+// not match.
+func (s SetValue) Union(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	diags := s.validateSetOperation(other, "compute the union of sets")
+
+	if diags.HasError() || s.IsUnknown() || other.IsUnknown() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(s.elements)+len(other.elements))
+
+	elements = append(elements, s.elements...)
+
+	index, indexDiags := newSetElementIndexOf(ctx, s.elementType, s.elements)
+
+	diags.Append(indexDiags...)
+
+	if indexDiags.HasError() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	for _, elem := range other.elements {
+		added, addDiags := index.add(ctx, elem)
+
+		diags.Append(addDiags...)
+
+		if addDiags.HasError() {
+			return NewSetUnknown(s.elementType), diags
+		}
+
+		if added {
+			elements = append(elements, elem)
+		}
+	}
+
+	set, setDiags := NewSetValue(s.elementType, elements)
+	set.stateRedactedPaths = combineStateRedactedPaths(s.stateRedactedPaths, other.stateRedactedPaths)
+
+	diags.Append(setDiags...)
+
+	return set, diags
+}
+
+// Difference returns a new Set containing the elements of the Set which are
+// not contained in `other`, using the same element comparison as Contains.
+// Elements which are not fully known are never contained in `other`, so are
+// always kept.
+//
+// If either Set is unknown, an unknown Set is returned. An error diagnostic
+// is returned if either Set is null, or if the element types of the Sets do
+// not match.
+func (s SetValue) Difference(ctx context.Context, other SetValue) (SetValue, diag.Diagnostics) {
+	diags := s.validateSetOperation(other, "compute the difference of sets")
+
+	if diags.HasError() || s.IsUnknown() || other.IsUnknown() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(s.elements))
+
+	otherIndex, otherIndexDiags := newSetElementIndexOf(ctx, other.elementType, other.elements)
+
+	diags.Append(otherIndexDiags...)
+
+	if otherIndexDiags.HasError() {
+		return NewSetUnknown(s.elementType), diags
+	}
+
+	for _, elem := range s.elements {
+		contains, containsDiags := otherIndex.contains(ctx, elem)
+
+		diags.Append(containsDiags...)
+
+		if containsDiags.HasError() {
+			return NewSetUnknown(s.elementType), diags
+		}
+
+		if !contains {
+			elements = append(elements, elem)
+		}
+	}
+
+	set, setDiags := NewSetValue(s.elementType, elements)
+	set.stateRedactedPaths = s.stateRedactedPaths
+
+	diags.Append(setDiags...)
+
+	return set, diags
+}
+
+// validateSetOperation returns an error diagnostic if either Set is null, or
+// if the element types of the Sets do not match, describing the attempted
+// `operation`. Unknown Sets are permitted.
+func (s SetValue) validateSetOperation(other SetValue, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, set := range []SetValue{s, other} {
+		if set.IsNull() {
+			diags.Append(set.validateKnown(operation)...)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return s.validateElementType(other, operation)
+}
+
+// validateElementType returns an error diagnostic if the element types of
+// the Sets do not match, describing the attempted `operation`.
+func (s SetValue) validateElementType(other SetValue, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !s.elementType.Equal(other.elementType) {
+		diags.AddError(
+			"Set Element Type Mismatch",
+			fmt.Sprintf("An unexpected error was encountered trying to %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				fmt.Sprintf("Set Element Type: %s\n", s.elementType)+
+				fmt.Sprintf("Other Set Element Type: %s", other.elementType),
+		)
+	}
+
+	return diags
+}
+
+// validateKnown returns an error diagnostic if the Set is null or unknown,
+// describing the attempted `operation`.
+func (s SetValue) validateKnown(operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch s.state {
+	case attr.ValueStateNull:
+		diags.AddError(
+			"Null Set Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				"The set is null.",
+		)
+	case attr.ValueStateUnknown:
+		diags.AddError(
+			"Unknown Set Value",
+			fmt.Sprintf("An unexpected error was encountered trying to %s. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation)+
+				"The set is unknown.",
+		)
+	}
+
+	return diags
+}
+
+// ToList returns a List containing the elements of the Set with the same
+// element type. Elements are ordered by their String method representation,
+// so the order is deterministic regardless of the order in which the Set was
+// created. Null and unknown Sets are returned as null and unknown Lists.
+func (s SetValue) ToList(_ context.Context) (ListValue, diag.Diagnostics) {
+	switch s.state {
+	case attr.ValueStateNull:
+		return NewListNull(s.elementType), nil
+	case attr.ValueStateUnknown:
+		return NewListUnknown(s.elementType), nil
+	}
+
+	elements := s.Elements()
+
+	sort.SliceStable(elements, func(i, j int) bool {
+		return elements[i].String() < elements[j].String()
+	})
+
+	return NewListValue(s.elementType, elements)
+}
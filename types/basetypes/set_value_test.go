@@ -5,16 +5,109 @@ package basetypes
 
 import (
 	"context"
+	"math/big"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestSetValueElementsAsBounded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          SetValue
+		allowUnhandled bool
+		minItems       int
+		maxItems       int
+		expected       []string
+		expectedDiags  diag.Diagnostics
+	}{
+		"within-bounds": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 1,
+			maxItems: 2,
+			expected: []string{"hello", "world"},
+		},
+		"below-min": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 3,
+			maxItems: 5,
+			expected: []string{"hello", "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected between 3 and 5 elements, got: 2.",
+				),
+			},
+		},
+		"above-max": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("hello"), NewStringValue("world")}),
+			minItems: 0,
+			maxItems: 1,
+			expected: []string{"hello", "world"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Count",
+					"Expected at most 1 elements, got: 2.",
+				),
+			},
+		},
+		"null": {
+			input:          NewSetNull(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown": {
+			input:          NewSetUnknown(StringType{}),
+			allowUnhandled: true,
+			minItems:       1,
+			maxItems:       2,
+		},
+		"unknown-disallow-unhandled": {
+			input:    NewSetUnknown(StringType{}),
+			minItems: 1,
+			maxItems: 2,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to build a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Received unknown value, however the target type cannot handle unknown values. Use the corresponding `types` package type or a custom type that handles unknown values.\n\n"+
+						"Path: \nTarget Type: []string\nSuggested Type: basetypes.SetValue",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			diags := testCase.input.ElementsAsBounded(context.Background(), &got, testCase.allowUnhandled, testCase.minItems, testCase.maxItems)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestSetElementsAs_stringSlice(t *testing.T) {
 	t.Parallel()
 
@@ -115,6 +208,8 @@ func TestSetTypeValidate(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
+		setType       SetType
+		providerValue bool
 		in            tftypes.Value
 		expectedDiags diag.Diagnostics
 	}{
@@ -138,6 +233,13 @@ func TestSetTypeValidate(t *testing.T) {
 					tftypes.NewValue(tftypes.String, nil),
 				},
 			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 1, which is not permitted.",
+				),
+			},
 		},
 		"null-elements": {
 			in: tftypes.NewValue(
@@ -150,10 +252,21 @@ func TestSetTypeValidate(t *testing.T) {
 				},
 			),
 			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 1, which is not permitted.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 2, which is not permitted.",
+				),
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Duplicate Set Element",
-					"This attribute contains duplicate values of: tftypes.String<null>",
+					"This attribute contains duplicate values of: tftypes.String<null>\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
 				),
 			},
 		},
@@ -206,6 +319,13 @@ func TestSetTypeValidate(t *testing.T) {
 					tftypes.NewValue(tftypes.String, nil),
 				},
 			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 2, which is not permitted.",
+				),
+			},
 		},
 		"value-and-unknown": {
 			in: tftypes.NewValue(
@@ -243,19 +363,20 @@ func TestSetTypeValidate(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Duplicate Set Element",
-					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
 				),
 			},
 		},
-		"values-duplicates-and-unknowns": {
+		"values-duplicates-multiple": {
 			in: tftypes.NewValue(
 				tftypes.Set{
 					ElementType: tftypes.String,
 				},
 				[]tftypes.Value{
-					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
 					tftypes.NewValue(tftypes.String, "hello"),
-					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "hello"),
 					tftypes.NewValue(tftypes.String, "hello"),
 				},
 			),
@@ -263,205 +384,1832 @@ func TestSetTypeValidate(t *testing.T) {
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
 					"Duplicate Set Element",
-					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 3 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 4 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 3 and 4 are equal.",
 				),
 			},
 		},
-		"wrong-value-type": {
-			in: tftypes.NewValue(tftypes.List{
-				ElementType: tftypes.String,
-			}, []tftypes.Value{
-				tftypes.NewValue(tftypes.String, "testvalue"),
-			}),
+		"values-nested-set-duplicates": {
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.Set{
+						ElementType: tftypes.String,
+					},
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.String,
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "hello"),
+						},
+					),
+					tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.String,
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "world"),
+						},
+					),
+					tftypes.NewValue(
+						tftypes.Set{
+							ElementType: tftypes.String,
+						},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "hello"),
+						},
+					),
+				},
+			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
 					path.Root("test"),
-					"Set Type Validation Error",
-					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"expected Set value, received tftypes.Value with value: tftypes.List[tftypes.String]<tftypes.String<\"testvalue\">>",
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.Set[tftypes.String]<tftypes.String<\"hello\">>\n\n"+
+						"The elements at configuration order positions 1 and 3 are equal.",
 				),
 			},
 		},
-	}
-	for name, testCase := range testCases {
-		name, testCase := name, testCase
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
-
-			diags := SetType{}.Validate(context.Background(), testCase.in, path.Root("test"))
-
-			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
-				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
-			}
-		})
-	}
-}
-
-func TestNewSetValue(t *testing.T) {
-	t.Parallel()
-
-	testCases := map[string]struct {
-		elementType   attr.Type
-		elements      []attr.Value
-		expected      SetValue
-		expectedDiags diag.Diagnostics
-	}{
-		"valid-no-elements": {
-			elementType: StringType{},
-			elements:    []attr.Value{},
-			expected:    NewSetValueMust(StringType{}, []attr.Value{}),
-		},
-		"valid-elements": {
-			elementType: StringType{},
-			elements: []attr.Value{
-				NewStringNull(),
-				NewStringUnknown(),
-				NewStringValue("test"),
+		"max-element-diagnostics-over-limit": {
+			setType: SetType{
+				ElemType:              StringType{},
+				MaxElementDiagnostics: 1,
 			},
-			expected: NewSetValueMust(
-				StringType{},
-				[]attr.Value{
-					NewStringNull(),
-					NewStringUnknown(),
-					NewStringValue("test"),
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "world"),
 				},
 			),
-		},
-		"invalid-element-type": {
-			elementType: StringType{},
-			elements: []attr.Value{
-				NewStringValue("test"),
-				NewBoolValue(true),
-			},
-			expected: NewSetUnknown(StringType{}),
 			expectedDiags: diag.Diagnostics{
-				diag.NewErrorDiagnostic(
-					"Invalid Set Element Type",
-					"While creating a Set value, an invalid element was detected. "+
-						"A Set must use the single, given element type. "+
-						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
-						"Set Element Type: basetypes.StringType\n"+
-						"Set Index (1) Element Type: basetypes.BoolType",
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Too Many Element Diagnostics",
+					"Element diagnostics were limited to 1, and 1 more were omitted.",
 				),
 			},
 		},
-	}
-
-	for name, testCase := range testCases {
-		name, testCase := name, testCase
-
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
-
-			got, diags := NewSetValue(testCase.elementType, testCase.elements)
-
-			if diff := cmp.Diff(got, testCase.expected); diff != "" {
-				t.Errorf("unexpected difference: %s", diff)
-			}
-
-			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
-				t.Errorf("unexpected diagnostics difference: %s", diff)
-			}
-		})
-	}
-}
-
-func TestNewSetValueFrom(t *testing.T) {
-	t.Parallel()
-
-	testCases := map[string]struct {
-		elementType   attr.Type
-		elements      any
-		expected      SetValue
-		expectedDiags diag.Diagnostics
-	}{
-		"valid-StringType{}-[]attr.Value-empty": {
-			elementType: StringType{},
-			elements:    []attr.Value{},
-			expected: NewSetValueMust(
-				StringType{},
-				[]attr.Value{},
+		"values-case-differs-string-type": {
+			setType: SetType{
+				ElemType: StringType{},
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "A"),
+					tftypes.NewValue(tftypes.String, "a"),
+				},
 			),
 		},
-		"valid-StringType{}-[]types.String-empty": {
-			elementType: StringType{},
-			elements:    []StringValue{},
-			expected: NewSetValueMust(
-				StringType{},
-				[]attr.Value{},
+		"values-duplicates-and-unknowns": {
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
 			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 2 and 4 are equal.",
+				),
+			},
 		},
-		"valid-StringType{}-[]types.String": {
-			elementType: StringType{},
-			elements: []StringValue{
-				NewStringNull(),
-				NewStringUnknown(),
-				NewStringValue("test"),
+		"element-validation-and-duplicates-ordering": {
+			setType: SetType{
+				ElemType:                     slowValidateStringType{},
+				ElementValidationConcurrency: 4,
 			},
-			expected: NewSetValueMust(
-				StringType{},
-				[]attr.Value{
-					NewStringNull(),
-					NewStringUnknown(),
-					NewStringValue("test"),
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "2"),
+					tftypes.NewValue(tftypes.String, "1"),
+					tftypes.NewValue(tftypes.String, "x"),
+					tftypes.NewValue(tftypes.String, "1"),
+					tftypes.NewValue(tftypes.String, "2"),
 				},
 			),
-		},
-		"valid-StringType{}-[]*string": {
-			elementType: StringType{},
-			elements: []*string{
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("2")),
+					"Validated",
+					"2",
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("1")),
+					"Validated",
+					"1",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("x")),
+					"Unexpected Error",
+					`strconv.Atoi: parsing "x": invalid syntax`,
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("1")),
+					"Validated",
+					"1",
+				),
+				diag.NewAttributeWarningDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("2")),
+					"Validated",
+					"2",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("2")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"2\">\n\n"+
+						"The elements at configuration order positions 1 and 5 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("1")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"1\">\n\n"+
+						"The elements at configuration order positions 2 and 4 are equal.",
+				),
+			},
+		},
+		"values-duplicates-element-path": {
+			setType: SetType{
+				ElemType: StringType{},
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 2 and 4 are equal.",
+				),
+			},
+		},
+		"values-duplicates-objects-element-path": {
+			setType: SetType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType{},
+					},
+				},
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"name": tftypes.String,
+						},
+					},
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"name": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "hello"),
+					}),
+					tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"name": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "hello"),
+					}),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewObjectValueMust(
+						map[string]attr.Type{
+							"name": StringType{},
+						},
+						map[string]attr.Value{
+							"name": NewStringValue("hello"),
+						},
+					)),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.Object[\"name\":tftypes.String]<\"name\":tftypes.String<\"hello\">>\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
+				),
+			},
+		},
+		"semantic-equals-duplicates": {
+			setType: SetType{
+				ElemType: caseInsensitiveStringType{},
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "A"),
+					tftypes.NewValue(tftypes.String, "b"),
+					tftypes.NewValue(tftypes.String, "a"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(caseInsensitiveStringValue{StringValue: NewStringValue("a")}),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"a\">\n\n"+
+						"The elements at configuration order positions 1 and 3 are equal.",
+				),
+			},
+		},
+		"semantic-equals-null-and-unknown": {
+			setType: SetType{
+				ElemType:          caseInsensitiveStringType{},
+				AllowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "A"),
+					tftypes.NewValue(tftypes.String, nil),
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			),
+		},
+		"min-items-empty": {
+			setType: SetType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected at least 1 elements, got: 0.",
+				),
+			},
+		},
+		"min-items-null": {
+			setType: SetType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
 				nil,
-				pointer("test1"),
-				pointer("test2"),
+			),
+		},
+		"min-items-unknown": {
+			setType: SetType{
+				ElemType: StringType{},
+				MinItems: 1,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				tftypes.UnknownValue,
+			),
+		},
+		"max-items-exceeded-with-duplicates": {
+			setType: SetType{
+				ElemType: StringType{},
+				MaxItems: 2,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected at most 2 elements, got: 3.",
+				),
+			},
+		},
+		"max-items-with-max-element-diagnostics": {
+			setType: SetType{
+				ElemType:              StringType{},
+				MaxItems:              3,
+				MaxElementDiagnostics: 1,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.String, "world"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringValue("hello")),
+					"Duplicate Set Element",
+					"This attribute contains duplicate values of: tftypes.String<\"hello\">\n\n"+
+						"The elements at configuration order positions 1 and 2 are equal.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Too Many Element Diagnostics",
+					"Element diagnostics were limited to 1, and 1 more were omitted.",
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Element Count",
+					"Expected at most 3 elements, got: 4.",
+				),
+			},
+		},
+		"disallow-null-elements-null-element": {
+			setType: SetType{
+				ElemType:             StringType{},
+				DisallowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringNull()),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 2, which is not permitted.",
+				),
+			},
+		},
+		"allow-null-elements-null-element": {
+			setType: SetType{
+				ElemType:          StringType{},
+				AllowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		"allow-null-elements-value-and-null": {
+			setType: SetType{
+				ElemType:          StringType{},
+				AllowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		"allow-null-elements-disallow-null-elements": {
+			setType: SetType{
+				ElemType:             StringType{},
+				AllowNullElements:    true,
+				DisallowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringNull()),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 1, which is not permitted.",
+				),
+			},
+		},
+		"provider-value-null-element": {
+			setType: SetType{
+				ElemType: StringType{},
+			},
+			providerValue: true,
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+		},
+		"provider-value-disallow-null-elements": {
+			setType: SetType{
+				ElemType:             StringType{},
+				DisallowNullElements: true,
+			},
+			providerValue: true,
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringNull()),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 1, which is not permitted.",
+				),
+			},
+		},
+		"null-element-unknown-element": {
+			setType: SetType{
+				ElemType:             StringType{},
+				DisallowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.String, nil),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtSetValue(NewStringNull()),
+					"Null Set Element",
+					"This attribute contains a null element at configuration order position 2, which is not permitted.",
+				),
+			},
+		},
+		"disallow-null-elements-values": {
+			setType: SetType{
+				ElemType:             StringType{},
+				DisallowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+				},
+			),
+		},
+		"disallow-null-elements-unknown-element": {
+			setType: SetType{
+				ElemType:             StringType{},
+				DisallowNullElements: true,
+			},
+			in: tftypes.NewValue(
+				tftypes.Set{
+					ElementType: tftypes.String,
+				},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				},
+			),
+		},
+		"wrong-value-type": {
+			in: tftypes.NewValue(tftypes.List{
+				ElementType: tftypes.String,
+			}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "testvalue"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Set Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"expected Set value, received tftypes.Value with value: tftypes.List[tftypes.String]<tftypes.String<\"testvalue\">>",
+				),
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if testCase.providerValue {
+				ctx = fwcontext.WithProviderValue(ctx)
+			}
+
+			diags := testCase.setType.Validate(ctx, testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetTypeValidateTruncatedDuplicate(t *testing.T) {
+	t.Parallel()
+
+	ctx := fwcontext.WithMaxDiagnosticValueLength(context.Background(), 20)
+
+	in := tftypes.NewValue(
+		tftypes.Set{
+			ElementType: tftypes.String,
+		},
+		[]tftypes.Value{
+			tftypes.NewValue(tftypes.String, strings.Repeat("a", 100)),
+			tftypes.NewValue(tftypes.String, strings.Repeat("a", 100)),
+		},
+	)
+
+	expectedDiags := diag.Diagnostics{
+		diag.NewAttributeErrorDiagnostic(
+			path.Root("test").AtSetValue(NewStringValue(strings.Repeat("a", 100))),
+			"Duplicate Set Element",
+			"This attribute contains duplicate values of: tftypes.String<\"aaaa...\n\n"+
+				"The elements at configuration order positions 1 and 2 are equal.",
+		),
+	}
+
+	diags := SetType{ElemType: StringType{}}.Validate(ctx, in, path.Root("test"))
+
+	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
+		t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
+	}
+}
+
+func TestNewSetValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      []attr.Value
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-no-elements": {
+			elementType: StringType{},
+			elements:    []attr.Value{},
+			expected:    NewSetValueMust(StringType{}, []attr.Value{}),
+		},
+		"valid-elements": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringNull(),
+				NewStringUnknown(),
+				NewStringValue("test"),
+			},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringUnknown(),
+					NewStringValue("test"),
+				},
+			),
+		},
+		"invalid-element-type": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("test"),
+				NewBoolValue(true),
+			},
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Set Index (1) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValue(testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSetValueFrom(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   attr.Type
+		elements      any
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"valid-StringType{}-[]attr.Value-empty": {
+			elementType: StringType{},
+			elements:    []attr.Value{},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+		},
+		"valid-StringType{}-[]types.String-empty": {
+			elementType: StringType{},
+			elements:    []StringValue{},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{},
+			),
+		},
+		"valid-StringType{}-[]types.String": {
+			elementType: StringType{},
+			elements: []StringValue{
+				NewStringNull(),
+				NewStringUnknown(),
+				NewStringValue("test"),
+			},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringUnknown(),
+					NewStringValue("test"),
+				},
+			),
+		},
+		"valid-StringType{}-[]*string": {
+			elementType: StringType{},
+			elements: []*string{
+				nil,
+				pointer("test1"),
+				pointer("test2"),
+			},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringValue("test1"),
+					NewStringValue("test2"),
+				},
+			),
+		},
+		"valid-StringType{}-[]string": {
+			elementType: StringType{},
+			elements: []string{
+				"test1",
+				"test2",
+			},
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("test1"),
+					NewStringValue("test2"),
+				},
+			),
+		},
+		"invalid-not-slice": {
+			elementType: StringType{},
+			elements:    "oops",
+			expected:    NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty(),
+					"Set Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"expected Set value, received tftypes.Value with value: tftypes.String<\"oops\">",
+				),
+			},
+		},
+		"invalid-type": {
+			elementType: StringType{},
+			elements:    []bool{true},
+			expected:    NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Empty().AtListIndex(0),
+					"Value Conversion Error",
+					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValueFrom(context.Background(), testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewSetValueDeduped(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType      attr.Type
+		elements         []attr.Value
+		expectedElements []attr.Value
+		expectedDiags    diag.Diagnostics
+	}{
+		"semantic-equals-duplicates": {
+			elementType: caseInsensitiveStringType{},
+			elements: []attr.Value{
+				caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("a")},
+			},
+			expectedElements: []attr.Value{
+				caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+				caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+			},
+		},
+		"empty": {
+			elementType:      StringType{},
+			elements:         []attr.Value{},
+			expectedElements: []attr.Value{},
+		},
+		"no-duplicates": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			},
+			expectedElements: []attr.Value{
+				NewStringValue("b"),
+				NewStringValue("a"),
+			},
+		},
+		"duplicates": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+			},
+			expectedElements: []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+			},
+		},
+		"duplicates-first-occurrence-order": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("c"),
+				NewStringValue("a"),
+				NewStringValue("c"),
+				NewStringValue("b"),
+				NewStringValue("a"),
+			},
+			expectedElements: []attr.Value{
+				NewStringValue("c"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+			},
+		},
+		"duplicates-null": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringNull(),
+				NewStringValue("a"),
+				NewStringNull(),
+			},
+			expectedElements: []attr.Value{
+				NewStringNull(),
+				NewStringValue("a"),
+			},
+		},
+		"duplicates-unknown-retained": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("a"),
+				NewStringUnknown(),
+			},
+			expectedElements: []attr.Value{
+				NewStringUnknown(),
+				NewStringValue("a"),
+				NewStringUnknown(),
+			},
+		},
+		"duplicates-partially-unknown-retained": {
+			elementType: ListType{ElemType: StringType{}},
+			elements: []attr.Value{
+				NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			},
+			expectedElements: []attr.Value{
+				NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+				NewListValueMust(StringType{}, []attr.Value{NewStringUnknown()}),
+			},
+		},
+		"invalid-element-type": {
+			elementType: StringType{},
+			elements: []attr.Value{
+				NewStringValue("a"),
+				NewBoolValue(true),
+			},
+			expectedElements: []attr.Value{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Set Element Type",
+					"While creating a Set value, an invalid element was detected. "+
+						"A Set must use the single, given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Set Index (1) Element Type: basetypes.BoolType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewSetValueDeduped(context.Background(), testCase.elementType, testCase.elements)
+
+			if diff := cmp.Diff(got.Elements(), testCase.expectedElements); diff != "" {
+				t.Errorf("unexpected elements difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+// countingStringValue is a StringValue which counts the calls of its
+// ToTerraformValue method.
+type countingStringValue struct {
+	StringValue
+
+	calls *int
+}
+
+func (v countingStringValue) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	*v.calls++
+
+	return v.StringValue.ToTerraformValue(ctx)
+}
+
+func TestNewSetValueDedupedConvertsElementsOnce(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	elements := make([]attr.Value, 0, 100)
+
+	for i := 0; i < 100; i++ {
+		elements = append(elements, countingStringValue{
+			StringValue: NewStringValue(strconv.Itoa(i % 50)),
+			calls:       &calls,
+		})
+	}
+
+	set, diags := NewSetValueDeduped(context.Background(), StringType{}, elements)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %s", diags)
+	}
+
+	if set.Len() != 50 {
+		t.Errorf("expected 50 elements, got %d", set.Len())
+	}
+
+	if calls != len(elements) {
+		t.Errorf("expected %d ToTerraformValue calls, got %d", len(elements), calls)
+	}
+}
+
+func TestSetValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       SetValue
+		expectation tftypes.Value
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "world"),
+			}),
+		},
+		"known-duplicates": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("hello"),
+				},
+			),
+			// Duplicate validation does not occur during this method.
+			// This is okay, as tftypes allows duplicates.
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.String, "hello"),
+			}),
+		},
+		"known-partial-unknown": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringValue("hello, world"),
+				},
+			),
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
+		"known-partial-null": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringNull(),
+					NewStringValue("hello, world"),
+				},
+			),
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.String, "hello, world"),
+			}),
+		},
+		"unknown": {
+			input:       NewSetUnknown(StringType{}),
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+		},
+		"null": {
+			input:       NewSetNull(StringType{}),
+			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotErr := test.input.ToTerraformValue(context.Background())
+
+			if test.expectedErr == "" && gotErr != nil {
+				t.Errorf("Unexpected error: %s", gotErr)
+				return
+			}
+
+			if test.expectedErr != "" {
+				if gotErr == nil {
+					t.Errorf("Expected error to be %q, got none", test.expectedErr)
+					return
+				}
+
+				if test.expectedErr != gotErr.Error() {
+					t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
+					return
+				}
+			}
+
+			if diff := cmp.Diff(got, test.expectation); diff != "" {
+				t.Errorf("Unexpected result (+got, -expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueElements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected []attr.Value
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: []attr.Value{NewStringValue("test")},
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: []attr.Value{},
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: []attr.Value{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.Elements()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueElements_immutable(t *testing.T) {
+	t.Parallel()
+
+	value := NewSetValueMust(StringType{}, []attr.Value{NewStringValue("original")})
+	value.Elements()[0] = NewStringValue("modified")
+
+	if !value.Equal(NewSetValueMust(StringType{}, []attr.Value{NewStringValue("original")})) {
+		t.Fatal("unexpected Elements mutation")
+	}
+}
+
+func TestSetValueElementType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected attr.Type
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: StringType{},
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: StringType{},
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: StringType{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.ElementType(context.Background())
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueEqual(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		receiver SetValue
+		input    attr.Value
+		expected bool
+	}
+	tests := map[string]testCase{
+		"known-known": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expected: true,
+		},
+		"known-known-diff-order": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("world"),
+					NewStringValue("hello"),
+				},
+			),
+			expected: true,
+		},
+		"known-known-repeated-elements-diff-order": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+					NewStringValue("hello"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("world"),
+					NewStringValue("hello"),
+					NewStringValue("hello"),
+				},
+			),
+			expected: true,
+		},
+		"known-known-repeated-elements-diff-multiplicity": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+					NewStringValue("world"),
+				},
+			),
+			expected: false,
+		},
+		"known-known-diff-value": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("goodnight"),
+					NewStringValue("moon"),
+				},
+			),
+			expected: false,
+		},
+		"known-known-diff-length": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+					NewStringValue("extra"),
+				},
+			),
+			expected: false,
+		},
+		"known-known-diff-type": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewSetValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(false),
+					NewBoolValue(true),
+				},
+			),
+			expected: false,
+		},
+		"known-known-diff-unknown": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringUnknown(),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expected: false,
+		},
+		"known-known-diff-null": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringNull(),
+				},
+			),
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expected: false,
+		},
+		"known-unknown": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input:    NewSetUnknown(StringType{}),
+			expected: false,
+		},
+		"known-null": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input:    NewSetNull(StringType{}),
+			expected: false,
+		},
+		"known-diff-type": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input: NewListValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expected: false,
+		},
+		"known-nil": {
+			receiver: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			input:    nil,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.receiver.Equal(test.input)
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetValueIsNull(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected bool
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: false,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: true,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsNull()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueIsUnknown(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    SetValue
+		expected bool
+	}{
+		"known": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
+			expected: false,
+		},
+		"null": {
+			input:    NewSetNull(StringType{}),
+			expected: false,
+		},
+		"unknown": {
+			input:    NewSetUnknown(StringType{}),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.IsUnknown()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueString(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       SetValue
+		expectation string
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expectation: `["hello","world"]`,
+		},
+		"known-set-of-sets": {
+			input: NewSetValueMust(
+				SetType{
+					ElemType: StringType{},
+				},
+				[]attr.Value{
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("hello"),
+							NewStringValue("world"),
+						},
+					),
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("foo"),
+							NewStringValue("bar"),
+						},
+					),
+				},
+			),
+			expectation: `[["hello","world"],["foo","bar"]]`,
+		},
+		"unknown": {
+			input:       NewSetUnknown(StringType{}),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewSetNull(StringType{}),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       SetValue{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.String()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestSetValueStringSorted(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       SetValue
+		expectation string
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("world"),
+					NewStringValue("hello"),
+				},
+			),
+			expectation: `["hello","world"]`,
+		},
+		"known-set-of-sets": {
+			input: NewSetValueMust(
+				SetType{
+					ElemType: StringType{},
+				},
+				[]attr.Value{
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("world"),
+							NewStringValue("hello"),
+						},
+					),
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("foo"),
+							NewStringValue("bar"),
+						},
+					),
+				},
+			),
+			expectation: `[["bar","foo"],["hello","world"]]`,
+		},
+		"known-partial-unknown": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringUnknown(),
+					NewStringValue("hello"),
+				},
+			),
+			expectation: `["hello",<unknown>]`,
+		},
+		"unknown": {
+			input:       NewSetUnknown(StringType{}),
+			expectation: "<unknown>",
+		},
+		"null": {
+			input:       NewSetNull(StringType{}),
+			expectation: "<null>",
+		},
+		"zero-value": {
+			input:       SetValue{},
+			expectation: "<null>",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.StringSorted()
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestSetValueStringSortedInsertionOrder(t *testing.T) {
+	t.Parallel()
+
+	elements := []attr.Value{
+		NewStringValue("c"),
+		NewStringValue("a"),
+		NewStringValue("b"),
+		NewStringNull(),
+	}
+
+	expected := NewSetValueMust(StringType{}, elements).StringSorted()
+
+	orders := [][]int{
+		{1, 2, 0, 3},
+		{3, 2, 1, 0},
+		{2, 3, 0, 1},
+	}
+
+	for _, order := range orders {
+		reordered := make([]attr.Value, 0, len(order))
+
+		for _, idx := range order {
+			reordered = append(reordered, elements[idx])
+		}
+
+		got := NewSetValueMust(StringType{}, reordered).StringSorted()
+
+		if got != expected {
+			t.Errorf("Expected %q for insertion order %v, got %q", expected, order, got)
+		}
+	}
+}
+
+func TestSetValueType(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		input       SetValue
+		expectation attr.Type
+	}
+	tests := map[string]testCase{
+		"known": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewStringValue("world"),
+				},
+			),
+			expectation: SetType{ElemType: StringType{}},
+		},
+		"known-set-of-sets": {
+			input: NewSetValueMust(
+				SetType{
+					ElemType: StringType{},
+				},
+				[]attr.Value{
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("hello"),
+							NewStringValue("world"),
+						},
+					),
+					NewSetValueMust(
+						StringType{},
+						[]attr.Value{
+							NewStringValue("foo"),
+							NewStringValue("bar"),
+						},
+					),
+				},
+			),
+			expectation: SetType{
+				ElemType: SetType{
+					ElemType: StringType{},
+				},
 			},
-			expected: NewSetValueMust(
+		},
+		"unknown": {
+			input:       NewSetUnknown(StringType{}),
+			expectation: SetType{ElemType: StringType{}},
+		},
+		"null": {
+			input:       NewSetNull(StringType{}),
+			expectation: SetType{ElemType: StringType{}},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.input.Type(context.Background())
+			if !cmp.Equal(got, test.expectation) {
+				t.Errorf("Expected %q, got %q", test.expectation, got)
+			}
+		})
+	}
+}
+
+func TestSetValueMinMax(t *testing.T) {
+	t.Parallel()
+
+	stringLess := func(a, b attr.Value) bool {
+		aString, _ := a.(StringValue)
+		bString, _ := b.(StringValue)
+
+		return aString.ValueString() < bString.ValueString()
+	}
+	numberLess := func(a, b attr.Value) bool {
+		aNumber, _ := a.(NumberValue)
+		bNumber, _ := b.(NumberValue)
+
+		return aNumber.ValueBigFloat().Cmp(bNumber.ValueBigFloat()) < 0
+	}
+
+	testCases := map[string]struct {
+		input       SetValue
+		less        func(a, b attr.Value) bool
+		expectedMin attr.Value
+		expectedMax attr.Value
+		expectedOk  bool
+	}{
+		"string": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("beta"),
+					NewStringValue("alpha"),
+					NewStringValue("gamma"),
+				},
+			),
+			less:        stringLess,
+			expectedMin: NewStringValue("alpha"),
+			expectedMax: NewStringValue("gamma"),
+			expectedOk:  true,
+		},
+		"string-null-unknown-elements": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
 					NewStringNull(),
-					NewStringValue("test1"),
-					NewStringValue("test2"),
+					NewStringValue("beta"),
+					NewStringUnknown(),
 				},
 			),
+			less:        stringLess,
+			expectedMin: NewStringValue("beta"),
+			expectedMax: NewStringValue("beta"),
+			expectedOk:  true,
 		},
-		"valid-StringType{}-[]string": {
-			elementType: StringType{},
-			elements: []string{
-				"test1",
-				"test2",
-			},
-			expected: NewSetValueMust(
+		"number": {
+			input: NewSetValueMust(
+				NumberType{},
+				[]attr.Value{
+					NewNumberValue(big.NewFloat(2.5)),
+					NewNumberValue(big.NewFloat(-1)),
+					NewNumberValue(big.NewFloat(10)),
+				},
+			),
+			less:        numberLess,
+			expectedMin: NewNumberValue(big.NewFloat(-1)),
+			expectedMax: NewNumberValue(big.NewFloat(10)),
+			expectedOk:  true,
+		},
+		"empty": {
+			input: NewSetValueMust(StringType{}, []attr.Value{}),
+			less:  stringLess,
+		},
+		"only-unknown-elements": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("test1"),
-					NewStringValue("test2"),
+					NewStringUnknown(),
 				},
 			),
+			less: stringLess,
 		},
-		"invalid-not-slice": {
-			elementType: StringType{},
-			elements:    "oops",
-			expected:    NewSetUnknown(StringType{}),
-			expectedDiags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(
-					path.Empty(),
-					"Set Type Validation Error",
-					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"expected Set value, received tftypes.Value with value: tftypes.String<\"oops\">",
-				),
-			},
+		"null": {
+			input: NewSetNull(StringType{}),
+			less:  stringLess,
 		},
-		"invalid-type": {
-			elementType: StringType{},
-			elements:    []bool{true},
-			expected:    NewSetUnknown(StringType{}),
-			expectedDiags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(
-					path.Empty().AtListIndex(0),
-					"Value Conversion Error",
-					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
-						"can't unmarshal tftypes.Bool into *string, expected string",
-				),
-			},
+		"unknown": {
+			input: NewSetUnknown(StringType{}),
+			less:  stringLess,
 		},
 	}
 
@@ -471,140 +2219,256 @@ func TestNewSetValueFrom(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got, diags := NewSetValueFrom(context.Background(), testCase.elementType, testCase.elements)
+			gotMin, ok := testCase.input.Min(testCase.less)
 
-			if diff := cmp.Diff(got, testCase.expected); diff != "" {
-				t.Errorf("unexpected difference: %s", diff)
+			if ok != testCase.expectedOk {
+				t.Errorf("expected Min ok %t, got %t", testCase.expectedOk, ok)
 			}
 
-			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
-				t.Errorf("unexpected diagnostics difference: %s", diff)
+			if diff := cmp.Diff(gotMin, testCase.expectedMin); diff != "" {
+				t.Errorf("unexpected Min difference: %s", diff)
+			}
+
+			gotMax, ok := testCase.input.Max(testCase.less)
+
+			if ok != testCase.expectedOk {
+				t.Errorf("expected Max ok %t, got %t", testCase.expectedOk, ok)
+			}
+
+			if diff := cmp.Diff(gotMax, testCase.expectedMax); diff != "" {
+				t.Errorf("unexpected Max difference: %s", diff)
 			}
 		})
 	}
 }
 
-func TestSetValueToTerraformValue(t *testing.T) {
+func TestSetValueToListDedupByAttribute(t *testing.T) {
 	t.Parallel()
 
-	type testCase struct {
-		input       SetValue
-		expectation tftypes.Value
-		expectedErr string
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   StringType{},
+			"name": StringType{},
+		},
 	}
-	tests := map[string]testCase{
-		"known": {
+
+	newObject := func(id, name StringValue) ObjectValue {
+		return NewObjectValueMust(
+			objectType.AttrTypes,
+			map[string]attr.Value{
+				"id":   id,
+				"name": name,
+			},
+		)
+	}
+
+	testCases := map[string]struct {
+		input         SetValue
+		keyAttr       string
+		expected      ListValue
+		expectedDiags diag.Diagnostics
+	}{
+		"distinct": {
 			input: NewSetValueMust(
-				StringType{},
+				objectType,
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					newObject(NewStringValue("b"), NewStringValue("second")),
+					newObject(NewStringValue("c"), NewStringValue("third")),
+					newObject(NewStringValue("a"), NewStringValue("first")),
 				},
 			),
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
-				tftypes.NewValue(tftypes.String, "hello"),
-				tftypes.NewValue(tftypes.String, "world"),
-			}),
-		},
-		"known-duplicates": {
-			input: NewSetValueMust(
-				StringType{},
+			keyAttr: "id",
+			expected: NewListValueMust(
+				objectType,
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("hello"),
+					newObject(NewStringValue("a"), NewStringValue("first")),
+					newObject(NewStringValue("b"), NewStringValue("second")),
+					newObject(NewStringValue("c"), NewStringValue("third")),
 				},
 			),
-			// Duplicate validation does not occur during this method.
-			// This is okay, as tftypes allows duplicates.
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
-				tftypes.NewValue(tftypes.String, "hello"),
-				tftypes.NewValue(tftypes.String, "hello"),
-			}),
 		},
-		"known-partial-unknown": {
+		"empty": {
+			input:    NewSetValueMust(objectType, []attr.Value{}),
+			keyAttr:  "id",
+			expected: NewListValueMust(objectType, []attr.Value{}),
+		},
+		"null": {
+			input:    NewSetNull(objectType),
+			keyAttr:  "id",
+			expected: NewListNull(objectType),
+		},
+		"unknown": {
+			input:    NewSetUnknown(objectType),
+			keyAttr:  "id",
+			expected: NewListUnknown(objectType),
+		},
+		"colliding": {
 			input: NewSetValueMust(
-				StringType{},
+				objectType,
 				[]attr.Value{
-					NewStringUnknown(),
-					NewStringValue("hello, world"),
+					newObject(NewStringValue("a"), NewStringValue("first")),
+					newObject(NewStringValue("a"), NewStringValue("second")),
 				},
 			),
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
-				tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-				tftypes.NewValue(tftypes.String, "hello, world"),
-			}),
+			keyAttr:  "id",
+			expected: NewListUnknown(objectType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Set Element Identity",
+					`This attribute contains multiple elements with the same "id" attribute value of "a": {"id":"a","name":"first"} and {"id":"a","name":"second"}`,
+				),
+			},
 		},
-		"known-partial-null": {
+		"null-key-attribute": {
 			input: NewSetValueMust(
-				StringType{},
+				objectType,
 				[]attr.Value{
-					NewStringNull(),
-					NewStringValue("hello, world"),
+					newObject(NewStringNull(), NewStringValue("first")),
 				},
 			),
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
-				tftypes.NewValue(tftypes.String, nil),
-				tftypes.NewValue(tftypes.String, "hello, world"),
-			}),
+			keyAttr:  "id",
+			expected: NewListUnknown(objectType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set To List Conversion Error",
+					`Set element attribute "id" must be known and non-null to identify the element, got element: {"id":<null>,"name":"first"}`,
+				),
+			},
 		},
-		"unknown": {
-			input:       NewSetUnknown(StringType{}),
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"missing-key-attribute": {
+			input:    NewSetValueMust(objectType, []attr.Value{}),
+			keyAttr:  "missing",
+			expected: NewListUnknown(objectType),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set To List Conversion Error",
+					"An unexpected error was encountered trying to convert set elements into a list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set element type types.ObjectType[\"id\":basetypes.StringType, \"name\":basetypes.StringType] does not contain attribute: missing",
+				),
+			},
 		},
-		"null": {
-			input:       NewSetNull(StringType{}),
-			expectation: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		"non-object-element-type": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("a")}),
+			keyAttr:  "id",
+			expected: NewListUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set To List Conversion Error",
+					"An unexpected error was encountered trying to convert set elements into a list. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set element type must be an object type, got: basetypes.StringType",
+				),
+			},
 		},
 	}
-	for name, test := range tests {
-		name, test := name, test
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
 
-			got, gotErr := test.input.ToTerraformValue(context.Background())
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
 
-			if test.expectedErr == "" && gotErr != nil {
-				t.Errorf("Unexpected error: %s", gotErr)
-				return
-			}
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
 
-			if test.expectedErr != "" {
-				if gotErr == nil {
-					t.Errorf("Expected error to be %q, got none", test.expectedErr)
-					return
-				}
+			got, diags := testCase.input.ToListDedupByAttribute(context.Background(), testCase.keyAttr)
 
-				if test.expectedErr != gotErr.Error() {
-					t.Errorf("Expected error to be %q, got %q", test.expectedErr, gotErr.Error())
-					return
-				}
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
 			}
 
-			if diff := cmp.Diff(got, test.expectation); diff != "" {
-				t.Errorf("Unexpected result (+got, -expected): %s", diff)
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
 			}
 		})
 	}
 }
 
-func TestSetValueElements(t *testing.T) {
+func TestSetValueContains(t *testing.T) {
 	t.Parallel()
 
+	set := NewSetValueMust(
+		StringType{},
+		[]attr.Value{
+			NewStringValue("hello"),
+			NewStringValue("world"),
+			NewStringUnknown(),
+		},
+	)
+
 	testCases := map[string]struct {
-		input    SetValue
-		expected []attr.Value
+		input         SetValue
+		candidate     attr.Value
+		expected      bool
+		expectedDiags diag.Diagnostics
 	}{
-		"known": {
-			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
-			expected: []attr.Value{NewStringValue("test")},
+		"contains": {
+			input:     set,
+			candidate: NewStringValue("hello"),
+			expected:  true,
+		},
+		"not-contains": {
+			input:     set,
+			candidate: NewStringValue("other"),
+			expected:  false,
+		},
+		"unknown-candidate": {
+			input:     set,
+			candidate: NewStringUnknown(),
+			expected:  false,
+		},
+		"semantic-equals": {
+			input: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("Hello")},
+				},
+			),
+			candidate: caseInsensitiveStringValue{StringValue: NewStringValue("HELLO")},
+			expected:  true,
 		},
-		"null": {
-			input:    NewSetNull(StringType{}),
-			expected: []attr.Value{},
+		"semantic-equals-not-contains": {
+			input: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("Hello")},
+				},
+			),
+			candidate: caseInsensitiveStringValue{StringValue: NewStringValue("world")},
+			expected:  false,
 		},
-		"unknown": {
-			input:    NewSetUnknown(StringType{}),
-			expected: []attr.Value{},
+		"null-set": {
+			input:     NewSetNull(StringType{}),
+			candidate: NewStringValue("hello"),
+			expected:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Set Value",
+					"An unexpected error was encountered trying to check set membership. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is null.",
+				),
+			},
+		},
+		"unknown-set": {
+			input:     NewSetUnknown(StringType{}),
+			candidate: NewStringValue("hello"),
+			expected:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Set Value",
+					"An unexpected error was encountered trying to check set membership. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is unknown.",
+				),
+			},
+		},
+		"mismatched-type": {
+			input:     set,
+			candidate: NewBoolValue(true),
+			expected:  false,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set Element Type Mismatch",
+					"An unexpected error was encountered trying to check set membership. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Candidate Type: basetypes.BoolType",
+				),
+			},
 		},
 	}
 
@@ -614,44 +2478,145 @@ func TestSetValueElements(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.input.Elements()
+			got, diags := testCase.input.Contains(context.Background(), testCase.candidate)
 
-			if diff := cmp.Diff(got, testCase.expected); diff != "" {
-				t.Errorf("unexpected difference: %s", diff)
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
 			}
-		})
-	}
-}
-
-func TestSetValueElements_immutable(t *testing.T) {
-	t.Parallel()
-
-	value := NewSetValueMust(StringType{}, []attr.Value{NewStringValue("original")})
-	value.Elements()[0] = NewStringValue("modified")
 
-	if !value.Equal(NewSetValueMust(StringType{}, []attr.Value{NewStringValue("original")})) {
-		t.Fatal("unexpected Elements mutation")
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
 	}
 }
 
-func TestSetValueElementType(t *testing.T) {
+func TestSetValueUnion(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		input    SetValue
-		expected attr.Type
+		input         SetValue
+		other         SetValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
 	}{
-		"known": {
-			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
-			expected: StringType{},
+		"semantic-equals": {
+			input: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+				},
+			),
+			other: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("a")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("c")},
+				},
+			),
+			expected: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("c")},
+				},
+			),
 		},
-		"null": {
-			input:    NewSetNull(StringType{}),
-			expected: StringType{},
+		"overlap": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringUnknown(),
+				},
+			),
+			other: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+					NewStringValue("c"),
+					NewStringUnknown(),
+				},
+			),
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringUnknown(),
+					NewStringValue("c"),
+					NewStringUnknown(),
+				},
+			),
 		},
-		"unknown": {
+		"disjoint": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			other: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("b"),
+				},
+			),
+			expected: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+		},
+		"null-other": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			other:    NewSetNull(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Set Value",
+					"An unexpected error was encountered trying to compute the union of sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is null.",
+				),
+			},
+		},
+		"unknown-receiver": {
 			input:    NewSetUnknown(StringType{}),
-			expected: StringType{},
+			other:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"unknown-other": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			other:    NewSetUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"mismatched-element-types": {
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			other: NewSetValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set Element Type Mismatch",
+					"An unexpected error was encountered trying to compute the union of sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
 		},
 	}
 
@@ -661,209 +2626,344 @@ func TestSetValueElementType(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.input.ElementType(context.Background())
+			got, diags := testCase.input.Union(context.Background(), testCase.other)
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
 		})
 	}
 }
 
-func TestSetValueEqual(t *testing.T) {
+func TestSetValueDifference(t *testing.T) {
 	t.Parallel()
 
-	type testCase struct {
-		receiver SetValue
-		input    attr.Value
-		expected bool
-	}
-	tests := map[string]testCase{
-		"known-known": {
-			receiver: NewSetValueMust(
-				StringType{},
+	testCases := map[string]struct {
+		input         SetValue
+		other         SetValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"semantic-equals": {
+			input: NewSetValueMust(
+				caseInsensitiveStringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("b")},
+				},
+			),
+			other: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("a")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("c")},
+				},
+			),
+			expected: NewSetValueMust(
+				caseInsensitiveStringType{},
+				[]attr.Value{
+					caseInsensitiveStringValue{StringValue: NewStringValue("b")},
 				},
 			),
+		},
+		"overlap": {
 			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringUnknown(),
 				},
 			),
-			expected: true,
-		},
-		"known-known-diff-value": {
-			receiver: NewSetValueMust(
+			other: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("b"),
+					NewStringValue("c"),
+					NewStringUnknown(),
 				},
 			),
-			input: NewSetValueMust(
+			expected: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("goodnight"),
-					NewStringValue("moon"),
+					NewStringValue("a"),
+					NewStringUnknown(),
 				},
 			),
-			expected: false,
 		},
-		"known-known-diff-length": {
-			receiver: NewSetValueMust(
+		"disjoint": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("a"),
 				},
 			),
-			input: NewSetValueMust(
+			other: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
-					NewStringValue("extra"),
+					NewStringValue("b"),
 				},
 			),
-			expected: false,
-		},
-		"known-known-diff-type": {
-			receiver: NewSetValueMust(
+			expected: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("a"),
 				},
 			),
+		},
+		"null-other": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			other:    NewSetNull(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Set Value",
+					"An unexpected error was encountered trying to compute the difference of sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is null.",
+				),
+			},
+		},
+		"unknown-receiver": {
+			input:    NewSetUnknown(StringType{}),
+			other:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"unknown-other": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			other:    NewSetUnknown(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+		},
+		"mismatched-element-types": {
 			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+				},
+			),
+			other: NewSetValueMust(
 				BoolType{},
 				[]attr.Value{
-					NewBoolValue(false),
 					NewBoolValue(true),
 				},
 			),
-			expected: false,
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set Element Type Mismatch",
+					"An unexpected error was encountered trying to compute the difference of sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
 		},
-		"known-known-diff-unknown": {
-			receiver: NewSetValueMust(
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.Difference(context.Background(), testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValueIntersect(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         SetValue
+		other         SetValue
+		expected      SetValue
+		expectedDiags diag.Diagnostics
+	}{
+		"common-elements": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
+					NewStringValue("a"),
+					NewStringValue("b"),
+					NewStringValue("c"),
 					NewStringUnknown(),
 				},
 			),
-			input: NewSetValueMust(
+			other: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("c"),
+					NewStringValue("b"),
+					NewStringValue("d"),
+					NewStringUnknown(),
 				},
 			),
-			expected: false,
-		},
-		"known-known-diff-null": {
-			receiver: NewSetValueMust(
+			expected: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringNull(),
+					NewStringValue("b"),
+					NewStringValue("c"),
 				},
 			),
+		},
+		"semantic-equals": {
 			input: NewSetValueMust(
-				StringType{},
+				caseInsensitiveStringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					caseInsensitiveStringValue{StringValue: NewStringValue("A")},
+					caseInsensitiveStringValue{StringValue: NewStringValue("b")},
 				},
 			),
-			expected: false,
-		},
-		"known-unknown": {
-			receiver: NewSetValueMust(
-				StringType{},
+			other: NewSetValueMust(
+				caseInsensitiveStringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					caseInsensitiveStringValue{StringValue: NewStringValue("a")},
 				},
 			),
-			input:    NewSetUnknown(StringType{}),
-			expected: false,
-		},
-		"known-null": {
-			receiver: NewSetValueMust(
-				StringType{},
+			expected: NewSetValueMust(
+				caseInsensitiveStringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					caseInsensitiveStringValue{StringValue: NewStringValue("A")},
 				},
 			),
-			input:    NewSetNull(StringType{}),
-			expected: false,
 		},
-		"known-diff-type": {
-			receiver: NewSetValueMust(
+		"no-common-elements": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("a"),
 				},
 			),
-			input: NewListValueMust(
+			other: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("b"),
 				},
 			),
-			expected: false,
+			expected: NewSetValueMust(StringType{}, []attr.Value{}),
 		},
-		"known-nil": {
-			receiver: NewSetValueMust(
+		"null-other": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			other:    NewSetNull(StringType{}),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Null Set Value",
+					"An unexpected error was encountered trying to intersect sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is null.",
+				),
+			},
+		},
+		"unknown-receiver": {
+			input:    NewSetUnknown(StringType{}),
+			other:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Set Value",
+					"An unexpected error was encountered trying to intersect sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is unknown.",
+				),
+			},
+		},
+		"mismatched-element-types": {
+			input: NewSetValueMust(
 				StringType{},
 				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
+					NewStringValue("a"),
 				},
 			),
-			input:    nil,
-			expected: false,
+			other: NewSetValueMust(
+				BoolType{},
+				[]attr.Value{
+					NewBoolValue(true),
+				},
+			),
+			expected: NewSetUnknown(StringType{}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Set Element Type Mismatch",
+					"An unexpected error was encountered trying to intersect sets. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Set Element Type: basetypes.StringType\n"+
+						"Other Set Element Type: basetypes.BoolType",
+				),
+			},
 		},
 	}
-	for name, test := range tests {
-		name, test := name, test
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := test.receiver.Equal(test.input)
-			if got != test.expected {
-				t.Errorf("Expected %v, got %v", test.expected, got)
+			got, diags := testCase.input.Intersect(context.Background(), testCase.other)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
 			}
 		})
 	}
 }
 
-func TestSetValueIsNull(t *testing.T) {
+func TestSetValueStringSlice(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		input    SetValue
-		expected bool
+		input         SetValue
+		expected      []string
+		expectedDiags diag.Diagnostics
 	}{
 		"known": {
-			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
-			expected: false,
+			input: NewSetValueMust(
+				StringType{},
+				[]attr.Value{
+					NewStringValue("a"),
+					NewStringValue("b"),
+				},
+			),
+			expected: []string{"a", "b"},
 		},
 		"null": {
 			input:    NewSetNull(StringType{}),
-			expected: true,
+			expected: nil,
 		},
 		"unknown": {
 			input:    NewSetUnknown(StringType{}),
-			expected: false,
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unknown Value",
+					"An unexpected error was encountered trying to convert set elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The set is unknown.",
+				),
+			},
+		},
+		"wrong-element-type": {
+			input:    NewSetValueMust(BoolType{}, []attr.Value{NewBoolValue(true)}),
+			expected: nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Element Type",
+					"An unexpected error was encountered trying to convert set elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Expected element type basetypes.StringType, got: basetypes.BoolType. Use ElementsAs for other element types.",
+				),
+			},
 		},
 	}
 
@@ -873,33 +2973,64 @@ func TestSetValueIsNull(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.input.IsNull()
+			got, diags := testCase.input.StringSlice(context.Background())
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
-				t.Errorf("unexpected difference: %s", diff)
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
 			}
 		})
 	}
 }
 
-func TestSetValueIsUnknown(t *testing.T) {
+func TestSetValueToList(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
-		input    SetValue
-		expected bool
+		input         SetValue
+		expected      ListValue
+		expectedDiags diag.Diagnostics
 	}{
-		"known": {
-			input:    NewSetValueMust(StringType{}, []attr.Value{NewStringValue("test")}),
-			expected: false,
-		},
 		"null": {
 			input:    NewSetNull(StringType{}),
-			expected: false,
+			expected: NewListNull(StringType{}),
 		},
 		"unknown": {
 			input:    NewSetUnknown(StringType{}),
-			expected: true,
+			expected: NewListUnknown(StringType{}),
+		},
+		"empty": {
+			input:    NewSetValueMust(StringType{}, []attr.Value{}),
+			expected: NewListValueMust(StringType{}, []attr.Value{}),
+		},
+		"ordered": {
+			input: NewSetValueMust(StringType{}, []attr.Value{
+				NewStringValue("c"),
+				NewStringValue("a"),
+				NewStringValue("b"),
+			}),
+			expected: NewListValueMust(StringType{}, []attr.Value{
+				NewStringValue("a"),
+				NewStringValue("b"),
+				NewStringValue("c"),
+			}),
+		},
+		"ordered-numbers": {
+			input: NewSetValueMust(Int64Type{}, []attr.Value{
+				NewInt64Value(3),
+				NewInt64Null(),
+				NewInt64Value(1),
+				NewInt64Unknown(),
+			}),
+			expected: NewListValueMust(Int64Type{}, []attr.Value{
+				NewInt64Value(1),
+				NewInt64Value(3),
+				NewInt64Null(),
+				NewInt64Unknown(),
+			}),
 		},
 	}
 
@@ -909,149 +3040,37 @@ func TestSetValueIsUnknown(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.input.IsUnknown()
+			got, diags := testCase.input.ToList(context.Background())
 
 			if diff := cmp.Diff(got, testCase.expected); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
-		})
-	}
-}
-
-func TestSetValueString(t *testing.T) {
-	t.Parallel()
-
-	type testCase struct {
-		input       SetValue
-		expectation string
-	}
-	tests := map[string]testCase{
-		"known": {
-			input: NewSetValueMust(
-				StringType{},
-				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
-				},
-			),
-			expectation: `["hello","world"]`,
-		},
-		"known-set-of-sets": {
-			input: NewSetValueMust(
-				SetType{
-					ElemType: StringType{},
-				},
-				[]attr.Value{
-					NewSetValueMust(
-						StringType{},
-						[]attr.Value{
-							NewStringValue("hello"),
-							NewStringValue("world"),
-						},
-					),
-					NewSetValueMust(
-						StringType{},
-						[]attr.Value{
-							NewStringValue("foo"),
-							NewStringValue("bar"),
-						},
-					),
-				},
-			),
-			expectation: `[["hello","world"],["foo","bar"]]`,
-		},
-		"unknown": {
-			input:       NewSetUnknown(StringType{}),
-			expectation: "<unknown>",
-		},
-		"null": {
-			input:       NewSetNull(StringType{}),
-			expectation: "<null>",
-		},
-		"zero-value": {
-			input:       SetValue{},
-			expectation: "<null>",
-		},
-	}
-
-	for name, test := range tests {
-		name, test := name, test
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
 
-			got := test.input.String()
-			if !cmp.Equal(got, test.expectation) {
-				t.Errorf("Expected %q, got %q", test.expectation, got)
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
 			}
 		})
 	}
 }
 
-func TestSetValueType(t *testing.T) {
+func TestSetValueToListDeterministic(t *testing.T) {
 	t.Parallel()
 
-	type testCase struct {
-		input       SetValue
-		expectation attr.Type
-	}
-	tests := map[string]testCase{
-		"known": {
-			input: NewSetValueMust(
-				StringType{},
-				[]attr.Value{
-					NewStringValue("hello"),
-					NewStringValue("world"),
-				},
-			),
-			expectation: SetType{ElemType: StringType{}},
-		},
-		"known-set-of-sets": {
-			input: NewSetValueMust(
-				SetType{
-					ElemType: StringType{},
-				},
-				[]attr.Value{
-					NewSetValueMust(
-						StringType{},
-						[]attr.Value{
-							NewStringValue("hello"),
-							NewStringValue("world"),
-						},
-					),
-					NewSetValueMust(
-						StringType{},
-						[]attr.Value{
-							NewStringValue("foo"),
-							NewStringValue("bar"),
-						},
-					),
-				},
-			),
-			expectation: SetType{
-				ElemType: SetType{
-					ElemType: StringType{},
-				},
-			},
-		},
-		"unknown": {
-			input:       NewSetUnknown(StringType{}),
-			expectation: SetType{ElemType: StringType{}},
-		},
-		"null": {
-			input:       NewSetNull(StringType{}),
-			expectation: SetType{ElemType: StringType{}},
-		},
-	}
-
-	for name, test := range tests {
-		name, test := name, test
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
-
-			got := test.input.Type(context.Background())
-			if !cmp.Equal(got, test.expectation) {
-				t.Errorf("Expected %q, got %q", test.expectation, got)
-			}
-		})
+	a := NewSetValueMust(StringType{}, []attr.Value{
+		NewStringValue("x"),
+		NewStringValue("y"),
+		NewStringValue("z"),
+	})
+	b := NewSetValueMust(StringType{}, []attr.Value{
+		NewStringValue("z"),
+		NewStringValue("x"),
+		NewStringValue("y"),
+	})
+
+	gotA, _ := a.ToList(context.Background())
+	gotB, _ := b.ToList(context.Background())
+
+	if diff := cmp.Diff(gotA, gotB); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
 	}
 }
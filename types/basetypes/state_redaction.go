@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// newStateRedactedPaths returns a copy of the given element-relative paths for
+// the WithStateRedactedPaths methods of ListValue, MapValue, and SetValue.
+//
+// When the collection is written to Terraform state, the values at these paths
+// within each element are replaced with null values. Paths are relative to the
+// element, such as path.Root("password") for an object element attribute,
+// while path.Empty() redacts the entire element. This prevents sensitive data,
+// such as ephemeral or write-only values, from being persisted in state.
+//
+// The redaction is only applied when the framework converts the value for
+// state, such as the (tfsdk.State).Set method. Conversions for configuration,
+// plan, and all other usage of the ToTerraformValue method are unaffected.
+// The redacted paths are not considered by the Equal method.
+//
+// Methods which return a collection of the same type, such as Slice, Union,
+// or Merge, retain the redacted paths. Methods which combine two collections
+// with elements from both, such as Union or Merge, retain the redacted paths
+// of both. Methods which convert to another element type or collection type,
+// such as Transform, ConvertValues, ToList, or ToSet, do not retain the
+// redacted paths.
+func newStateRedactedPaths(elementPaths []path.Path) []path.Path {
+	result := make([]path.Path, 0, len(elementPaths))
+
+	for _, elementPath := range elementPaths {
+		result = append(result, elementPath.Copy())
+	}
+
+	return result
+}
+
+// combineStateRedactedPaths returns the redacted paths of two collections
+// whose elements are combined, without duplicates.
+func combineStateRedactedPaths(paths, otherPaths []path.Path) []path.Path {
+	if len(otherPaths) == 0 {
+		return paths
+	}
+
+	if len(paths) == 0 {
+		return otherPaths
+	}
+
+	result := make(path.Paths, 0, len(paths)+len(otherPaths))
+
+	result.Append(paths...)
+	result.Append(otherPaths...)
+
+	return result
+}
+
+// stateRedactElement returns the given collection element with the values at
+// the element-relative redacted paths replaced with null values, if the
+// context is for Terraform state serialization. Otherwise, the element is
+// returned unmodified.
+func stateRedactElement(ctx context.Context, elem tftypes.Value, redactedPaths []path.Path) (tftypes.Value, error) {
+	if len(redactedPaths) == 0 || !fwcontext.IsStateSerialization(ctx) {
+		return elem, nil
+	}
+
+	tfPaths := make([]*tftypes.AttributePath, 0, len(redactedPaths))
+
+	for _, redactedPath := range redactedPaths {
+		tfPath, diags := totftypes.AttributePath(ctx, redactedPath)
+
+		if diags.HasError() {
+			return elem, fmt.Errorf("unable to convert redacted path %s: %v", redactedPath, diags)
+		}
+
+		tfPaths = append(tfPaths, tfPath)
+	}
+
+	return tftypes.Transform(elem, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		for _, tfPath := range tfPaths {
+			if p.Equal(tfPath) {
+				return tftypes.NewValue(v.Type(), nil), nil
+			}
+		}
+
+		return v, nil
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// validateStringElementType returns an error diagnostic if the collection
+// element type is not exactly StringType. The `collection` is the kind of
+// collection used in the diagnostic, such as "list".
+func validateStringElementType(collection string, elementType attr.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := elementType.(StringType); ok {
+		return diags
+	}
+
+	diags.AddError(
+		"Invalid Element Type",
+		fmt.Sprintf("An unexpected error was encountered trying to convert %s elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n", collection)+
+			fmt.Sprintf("Expected element type basetypes.StringType, got: %s. Use ElementsAs for other element types.", elementType),
+	)
+
+	return diags
+}
+
+// validateStringCollectionState returns an error diagnostic if the collection
+// is unknown. The `collection` is the kind of collection used in the
+// diagnostic, such as "list".
+func validateStringCollectionState(collection string, state attr.ValueState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state != attr.ValueStateUnknown {
+		return diags
+	}
+
+	diags.AddError(
+		"Unknown Value",
+		fmt.Sprintf("An unexpected error was encountered trying to convert %s elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n", collection)+
+			fmt.Sprintf("The %s is unknown.", collection),
+	)
+
+	return diags
+}
+
+// stringElementValue returns the Go string of a collection element and
+// whether the element is a known, non-null StringValue.
+func stringElementValue(element attr.Value) (string, bool) {
+	stringValue, ok := element.(StringValue)
+
+	if !ok || stringValue.state != attr.ValueStateKnown {
+		return "", false
+	}
+
+	return stringValue.value, true
+}
+
+// invalidStringElementDiagnostics returns the error diagnostic for a
+// collection element rejected by stringElementValue. The
+// `elementDescription` identifies the element in the diagnostic, such as
+// "list element 0".
+func invalidStringElementDiagnostics(elementDescription string, element attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, ok := element.(StringValue); !ok {
+		diags.AddError(
+			"Invalid Element Value",
+			"An unexpected error was encountered trying to convert collection elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected %s to be basetypes.StringValue, got: %T", elementDescription, element),
+		)
+
+		return diags
+	}
+
+	diags.AddError(
+		"Invalid Element Value",
+		"An unexpected error was encountered trying to convert collection elements to Go strings. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Expected %s to be known and not null, got: %s. Use ElementsAs with a target type that handles null or unknown values.", elementDescription, element),
+	)
+
+	return diags
+}
+
+// stringSliceFromElements converts the given collection elements into Go
+// strings without reflection. The `collection` is the kind of collection used
+// in diagnostics, such as "list".
+func stringSliceFromElements(collection string, elementType attr.Type, state attr.ValueState, elements []attr.Value) ([]string, diag.Diagnostics) {
+	diags := validateStringElementType(collection, elementType)
+
+	diags.Append(validateStringCollectionState(collection, state)...)
+
+	if diags.HasError() || state == attr.ValueStateNull {
+		return nil, diags
+	}
+
+	result := make([]string, len(elements))
+
+	for idx, element := range elements {
+		value, ok := stringElementValue(element)
+
+		if !ok {
+			diags.Append(invalidStringElementDiagnostics(fmt.Sprintf("%s element %d", collection, idx), element)...)
+
+			return nil, diags
+		}
+
+		result[idx] = value
+	}
+
+	return result, diags
+}
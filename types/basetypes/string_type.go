@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwcontext"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -76,6 +77,10 @@ func (t StringType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (a
 		return nil, err
 	}
 
+	// Interning is enabled by the server when configured, so repeated
+	// values can share storage. Interning does not change the value.
+	s = fwcontext.StringInterner(ctx).Intern(s)
+
 	return NewStringValue(s), nil
 }
 
@@ -6,6 +6,8 @@ package basetypes
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
@@ -181,6 +183,63 @@ func (s StringValue) ValueStringPointer() *string {
 	return &s.value
 }
 
+// MatchGroups returns the submatches of the given regular expression in the
+// known string value, as returned by regexp.Regexp.FindStringSubmatch, where
+// the first element is the match of the entire expression. If the value does
+// not match, an empty slice and an error diagnostic are returned. If String
+// is null or unknown, returns nil without diagnostics.
+func (s StringValue) MatchGroups(re *regexp.Regexp) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if s.IsNull() || s.IsUnknown() {
+		return nil, diags
+	}
+
+	matches := re.FindStringSubmatch(s.value)
+
+	if matches == nil {
+		diags.AddError(
+			"String Value Does Not Match Pattern",
+			fmt.Sprintf("The string value %q does not match the expected pattern: %s", s.value, re.String()),
+		)
+
+		return []string{}, diags
+	}
+
+	return matches, diags
+}
+
+// TrimSpace returns a String with the leading and trailing white space of
+// the known string value removed, as defined by strings.TrimSpace. If String
+// is null or unknown, returns String unchanged.
+func (s StringValue) TrimSpace() StringValue {
+	return s.transform(strings.TrimSpace)
+}
+
+// ToLower returns a String with the known string value mapped to lower case,
+// as defined by strings.ToLower. If String is null or unknown, returns String
+// unchanged.
+func (s StringValue) ToLower() StringValue {
+	return s.transform(strings.ToLower)
+}
+
+// ToUpper returns a String with the known string value mapped to upper case,
+// as defined by strings.ToUpper. If String is null or unknown, returns String
+// unchanged.
+func (s StringValue) ToUpper() StringValue {
+	return s.transform(strings.ToUpper)
+}
+
+// transform returns a String with the known string value replaced by the
+// result of `f`. Null and unknown values are returned unchanged.
+func (s StringValue) transform(f func(string) string) StringValue {
+	if s.state != attr.ValueStateKnown {
+		return s
+	}
+
+	return NewStringValue(f(s.value))
+}
+
 // ToStringValue returns String.
 func (s StringValue) ToStringValue(context.Context) (StringValue, diag.Diagnostics) {
 	return s, nil
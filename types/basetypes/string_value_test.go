@@ -5,10 +5,12 @@ package basetypes
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -315,6 +317,151 @@ func TestStringValueValueStringPointer(t *testing.T) {
 	}
 }
 
+func TestStringValueMatchGroups(t *testing.T) {
+	t.Parallel()
+
+	arnRegexp := regexp.MustCompile(`^arn:([^:]+):([^:]+):([^:]*):([^:]*):(.+)$`)
+
+	testCases := map[string]struct {
+		input         StringValue
+		expected      []string
+		expectedDiags diag.Diagnostics
+	}{
+		"match": {
+			input: NewStringValue("arn:aws:s3:::example-bucket"),
+			expected: []string{
+				"arn:aws:s3:::example-bucket",
+				"aws",
+				"s3",
+				"",
+				"",
+				"example-bucket",
+			},
+		},
+		"no-match": {
+			input:    NewStringValue("example-bucket"),
+			expected: []string{},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"String Value Does Not Match Pattern",
+					`The string value "example-bucket" does not match the expected pattern: ^arn:([^:]+):([^:]+):([^:]*):([^:]*):(.+)$`,
+				),
+			},
+		},
+		"null": {
+			input:    NewStringNull(),
+			expected: nil,
+		},
+		"unknown": {
+			input:    NewStringUnknown(),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.input.MatchGroups(arnRegexp)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValueTransforms(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input     StringValue
+		transform func(StringValue) StringValue
+		expected  StringValue
+	}{
+		"TrimSpace-known": {
+			input:     NewStringValue(" \t test value\n"),
+			transform: StringValue.TrimSpace,
+			expected:  NewStringValue("test value"),
+		},
+		"TrimSpace-unicode": {
+			input:     NewStringValue("\u00a0test\u2003"),
+			transform: StringValue.TrimSpace,
+			expected:  NewStringValue("test"),
+		},
+		"TrimSpace-null": {
+			input:     NewStringNull(),
+			transform: StringValue.TrimSpace,
+			expected:  NewStringNull(),
+		},
+		"TrimSpace-unknown": {
+			input:     NewStringUnknown(),
+			transform: StringValue.TrimSpace,
+			expected:  NewStringUnknown(),
+		},
+		"ToLower-known": {
+			input:     NewStringValue("Test Value"),
+			transform: StringValue.ToLower,
+			expected:  NewStringValue("test value"),
+		},
+		"ToLower-unicode": {
+			input:     NewStringValue("ÀÉÎÕÜ ΣΑΣ"),
+			transform: StringValue.ToLower,
+			expected:  NewStringValue("àéîõü σασ"),
+		},
+		"ToLower-null": {
+			input:     NewStringNull(),
+			transform: StringValue.ToLower,
+			expected:  NewStringNull(),
+		},
+		"ToLower-unknown": {
+			input:     NewStringUnknown(),
+			transform: StringValue.ToLower,
+			expected:  NewStringUnknown(),
+		},
+		"ToUpper-known": {
+			input:     NewStringValue("Test Value"),
+			transform: StringValue.ToUpper,
+			expected:  NewStringValue("TEST VALUE"),
+		},
+		"ToUpper-unicode": {
+			input:     NewStringValue("àéîõü σας"),
+			transform: StringValue.ToUpper,
+			expected:  NewStringValue("ÀÉÎÕÜ ΣΑΣ"),
+		},
+		"ToUpper-null": {
+			input:     NewStringNull(),
+			transform: StringValue.ToUpper,
+			expected:  NewStringNull(),
+		},
+		"ToUpper-unknown": {
+			input:     NewStringUnknown(),
+			transform: StringValue.ToUpper,
+			expected:  NewStringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.transform(testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestNewStringPointerValue(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ TupleTypable = TupleType{}
+
+// TupleTypable extends attr.Type for tuple types.
+// Implement this interface to create a custom TupleType type.
+type TupleTypable interface {
+	attr.Type
+
+	// ValueFromTuple should convert the Tuple to a TupleValuable type.
+	ValueFromTuple(context.Context, TupleValue) (TupleValuable, diag.Diagnostics)
+}
+
+// TupleType is an AttributeType representing a fixed-length sequence of
+// values. Each position may be of a different type, which the provider must
+// specify in order as the ElemTypes property.
+type TupleType struct {
+	ElemTypes []attr.Type
+}
+
+// ElementTypes returns the attr.Type elements will be created from, in order.
+func (t TupleType) ElementTypes() []attr.Type {
+	return t.ElemTypes
+}
+
+// TerraformType returns the tftypes.Type that should be used to
+// represent this type. This constrains what user input will be
+// accepted and what kind of data can be set in state. The framework
+// will use this to translate the AttributeType to something Terraform
+// can understand.
+func (t TupleType) TerraformType(ctx context.Context) tftypes.Type {
+	elemTypes := make([]tftypes.Type, 0, len(t.ElemTypes))
+
+	for _, elemType := range t.ElemTypes {
+		elemTypes = append(elemTypes, elemType.TerraformType(ctx))
+	}
+
+	return tftypes.Tuple{
+		ElementTypes: elemTypes,
+	}
+}
+
+// ValueFromTerraform returns an attr.Value given a tftypes.Value.
+// This is meant to convert the tftypes.Value into a more convenient Go
+// type for the provider to consume the data with.
+func (t TupleType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return NewTupleNull(t.ElemTypes), nil
+	}
+	inType, ok := in.Type().(tftypes.Tuple)
+	if !ok {
+		return nil, fmt.Errorf("can't use %s as value of TupleValue, can only use tftypes.Tuple values", in.String())
+	}
+	if len(inType.ElementTypes) != len(t.ElemTypes) {
+		return nil, fmt.Errorf("can't use %s as value of Tuple with %d element types, got %d element types", in.String(), len(t.ElemTypes), len(inType.ElementTypes))
+	}
+	if tupleType := t.TerraformType(ctx); !inType.Equal(tupleType) {
+		return nil, fmt.Errorf("can't use %s as value of %s, can only use %s values", in.String(), t.String(), tupleType.String())
+	}
+	if !in.IsKnown() {
+		return NewTupleUnknown(t.ElemTypes), nil
+	}
+	if in.IsNull() {
+		return NewTupleNull(t.ElemTypes), nil
+	}
+	val := []tftypes.Value{}
+	err := in.As(&val)
+	if err != nil {
+		return nil, err
+	}
+	elems := make([]attr.Value, 0, len(val))
+	for idx, elem := range val {
+		av, err := t.ElemTypes[idx].ValueFromTerraform(ctx, elem)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, av)
+	}
+	// ValueFromTerraform above on each element should make this safe.
+	// Otherwise, this will need to do some Diagnostics to error conversion.
+	return NewTupleValueMust(t.ElemTypes, elems), nil
+}
+
+// Equal returns true if `o` is also a TupleType and has the same ElemTypes in
+// the same order.
+func (t TupleType) Equal(o attr.Type) bool {
+	other, ok := o.(TupleType)
+	if !ok {
+		return false
+	}
+	if len(t.ElemTypes) != len(other.ElemTypes) {
+		return false
+	}
+	for idx, elemType := range t.ElemTypes {
+		if elemType == nil || !elemType.Equal(other.ElemTypes[idx]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ApplyTerraform5AttributePathStep applies the given AttributePathStep to the
+// tuple.
+func (t TupleType) ApplyTerraform5AttributePathStep(step tftypes.AttributePathStep) (interface{}, error) {
+	indexStep, ok := step.(tftypes.ElementKeyInt)
+	if !ok {
+		return nil, fmt.Errorf("cannot apply step %T to TupleType", step)
+	}
+
+	index := int(indexStep)
+
+	if index < 0 || index >= len(t.ElemTypes) {
+		return nil, fmt.Errorf("no element defined at index %d in TupleType", index)
+	}
+
+	return t.ElemTypes[index], nil
+}
+
+// String returns a human-friendly description of the TupleType.
+func (t TupleType) String() string {
+	elemTypes := make([]string, 0, len(t.ElemTypes))
+
+	for _, elemType := range t.ElemTypes {
+		elemTypes = append(elemTypes, elemType.String())
+	}
+
+	return "types.TupleType[" + strings.Join(elemTypes, ", ") + "]"
+}
+
+// ValueType returns the Value type.
+func (t TupleType) ValueType(_ context.Context) attr.Value {
+	return TupleValue{
+		elementTypes: t.ElemTypes,
+	}
+}
+
+// ValueFromTuple returns a TupleValuable type given a Tuple.
+func (t TupleType) ValueFromTuple(_ context.Context, tuple TupleValue) (TupleValuable, diag.Diagnostics) {
+	return tuple, nil
+}
+
+// CopyValue returns a deep copy of the given known Tuple value, with fresh
+// element storage. The copy is converted with the ValueFromTuple method of the
+// value type, so custom types are preserved. Elements are copied with
+// attr.Copy.
+func (t TupleType) CopyValue(ctx context.Context, v attr.Value) (attr.Value, error) {
+	valuable, ok := v.(TupleValuable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", v)
+	}
+
+	tuple, diags := valuable.ToTupleValue(ctx)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting value to TupleValue: %v", diags)
+	}
+
+	elements, err := copySliceValues(ctx, tuple.elements)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tuple.elements = elements
+	tuple.elementTypes = append(make([]attr.Type, 0, len(tuple.elementTypes)), tuple.elementTypes...)
+
+	typable, ok := v.Type(ctx).(TupleTypable)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of %T", v.Type(ctx))
+	}
+
+	result, diags := typable.ValueFromTuple(ctx, tuple)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting TupleValue to TupleValuable: %v", diags)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
+var testTupleElemTypes = []attr.Type{
+	StringType{},
+	Int64Type{},
+	BoolType{},
+}
+
+var testTupleTerraformType = tftypes.Tuple{
+	ElementTypes: []tftypes.Type{
+		tftypes.String,
+		tftypes.Number,
+		tftypes.Bool,
+	},
+}
+
+func TestTupleTypeTerraformType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    TupleType
+		expected tftypes.Type
+	}{
+		"empty": {
+			input:    TupleType{},
+			expected: tftypes.Tuple{ElementTypes: []tftypes.Type{}},
+		},
+		"string-int64-bool": {
+			input:    TupleType{ElemTypes: testTupleElemTypes},
+			expected: testTupleTerraformType,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.TerraformType(context.Background())
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver    TupleType
+		input       tftypes.Value
+		expected    attr.Value
+		expectedErr string
+	}{
+		"value": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input: tftypes.NewValue(testTupleTerraformType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Number, 123),
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expected: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(123),
+					NewBoolValue(true),
+				},
+			),
+		},
+		"value-null-elements": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input: tftypes.NewValue(testTupleTerraformType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, nil),
+				tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				tftypes.NewValue(tftypes.Bool, nil),
+			}),
+			expected: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringNull(),
+					NewInt64Unknown(),
+					NewBoolNull(),
+				},
+			),
+		},
+		"nil-type": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    tftypes.NewValue(nil, nil),
+			expected: NewTupleNull(testTupleElemTypes),
+		},
+		"null": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    tftypes.NewValue(testTupleTerraformType, nil),
+			expected: NewTupleNull(testTupleElemTypes),
+		},
+		"unknown": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    tftypes.NewValue(testTupleTerraformType, tftypes.UnknownValue),
+			expected: NewTupleUnknown(testTupleElemTypes),
+		},
+		"wrong-type": {
+			receiver:    TupleType{ElemTypes: testTupleElemTypes},
+			input:       tftypes.NewValue(tftypes.String, "wrong"),
+			expectedErr: `can't use tftypes.String<"wrong"> as value of TupleValue, can only use tftypes.Tuple values`,
+		},
+		"wrong-arity": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+				},
+			),
+			expectedErr: `can't use tftypes.Tuple[tftypes.String]<tftypes.String<"hello">> as value of Tuple with 3 element types, got 1 element types`,
+		},
+		"wrong-element-type": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input: tftypes.NewValue(
+				tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.String, tftypes.Bool}},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "hello"),
+					tftypes.NewValue(tftypes.String, "world"),
+					tftypes.NewValue(tftypes.Bool, true),
+				},
+			),
+			expectedErr: `can't use tftypes.Tuple[tftypes.String, tftypes.String, tftypes.Bool]<tftypes.String<"hello">, tftypes.String<"world">, tftypes.Bool<"true">> as value of types.TupleType[basetypes.StringType, basetypes.Int64Type, basetypes.BoolType], can only use tftypes.Tuple[tftypes.String, tftypes.Number, tftypes.Bool] values`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.receiver.ValueFromTerraform(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+			}
+
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleTypeApplyTerraform5AttributePathStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		step        tftypes.AttributePathStep
+		expected    interface{}
+		expectedErr string
+	}{
+		"element-key-int-first": {
+			step:     tftypes.ElementKeyInt(0),
+			expected: StringType{},
+		},
+		"element-key-int-last": {
+			step:     tftypes.ElementKeyInt(2),
+			expected: BoolType{},
+		},
+		"element-key-int-out-of-bounds": {
+			step:        tftypes.ElementKeyInt(3),
+			expectedErr: "no element defined at index 3 in TupleType",
+		},
+		"element-key-int-negative": {
+			step:        tftypes.ElementKeyInt(-1),
+			expectedErr: "no element defined at index -1 in TupleType",
+		},
+		"element-key-string": {
+			step:        tftypes.ElementKeyString("test"),
+			expectedErr: "cannot apply step tftypes.ElementKeyString to TupleType",
+		},
+		"attribute-name": {
+			step:        tftypes.AttributeName("test"),
+			expectedErr: "cannot apply step tftypes.AttributeName to TupleType",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := TupleType{ElemTypes: testTupleElemTypes}.ApplyTerraform5AttributePathStep(testCase.step)
+
+			if err != nil {
+				if testCase.expectedErr == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if err.Error() != testCase.expectedErr {
+					t.Fatalf("expected error %q, got %q", testCase.expectedErr, err)
+				}
+			}
+
+			if err == nil && testCase.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleTypeEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		receiver TupleType
+		input    attr.Type
+		expected bool
+	}{
+		"equal": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    TupleType{ElemTypes: []attr.Type{StringType{}, Int64Type{}, BoolType{}}},
+			expected: true,
+		},
+		"equal-empty": {
+			receiver: TupleType{},
+			input:    TupleType{ElemTypes: []attr.Type{}},
+			expected: true,
+		},
+		"different-order": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    TupleType{ElemTypes: []attr.Type{BoolType{}, Int64Type{}, StringType{}}},
+			expected: false,
+		},
+		"different-length": {
+			receiver: TupleType{ElemTypes: testTupleElemTypes},
+			input:    TupleType{ElemTypes: []attr.Type{StringType{}}},
+			expected: false,
+		},
+		"different-type": {
+			receiver: TupleType{ElemTypes: []attr.Type{StringType{}}},
+			input:    ListType{ElemType: StringType{}},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleTypeString(t *testing.T) {
+	t.Parallel()
+
+	got := TupleType{ElemTypes: testTupleElemTypes}.String()
+	expected := "types.TupleType[basetypes.StringType, basetypes.Int64Type, basetypes.BoolType]"
+
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
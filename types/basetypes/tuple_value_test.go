@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestNewTupleValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementTypes  []attr.Type
+		elements      []attr.Value
+		expected      TupleValue
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			elementTypes: testTupleElemTypes,
+			elements: []attr.Value{
+				NewStringValue("hello"),
+				NewInt64Value(123),
+				NewBoolValue(true),
+			},
+			expected: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(123),
+					NewBoolValue(true),
+				},
+			),
+		},
+		"invalid-length": {
+			elementTypes: testTupleElemTypes,
+			elements: []attr.Value{
+				NewStringValue("hello"),
+			},
+			expected: NewTupleUnknown(testTupleElemTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Elements",
+					"While creating a Tuple value, an invalid number of elements was detected. "+
+						"A Tuple must contain exactly one element for each given element type. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Tuple Element Types: 3\n"+
+						"Tuple Elements: 1",
+				),
+			},
+		},
+		"invalid-element-type": {
+			elementTypes: testTupleElemTypes,
+			elements: []attr.Value{
+				NewStringValue("hello"),
+				NewStringValue("world"),
+				NewBoolValue(true),
+			},
+			expected: NewTupleUnknown(testTupleElemTypes),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Tuple Element Type",
+					"While creating a Tuple value, an invalid element was detected. "+
+						"A Tuple must use the given element type at each index. "+
+						"This is always an issue with the provider and should be reported to the provider developers.\n\n"+
+						"Tuple Index (1) Expected Type: basetypes.Int64Type\n"+
+						"Tuple Index (1) Given Type: basetypes.StringType",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := NewTupleValue(testCase.elementTypes, testCase.elements)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleValueToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    TupleValue
+		expected tftypes.Value
+	}{
+		"value": {
+			input: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(123),
+					NewBoolValue(true),
+				},
+			),
+			expected: tftypes.NewValue(testTupleTerraformType, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "hello"),
+				tftypes.NewValue(tftypes.Number, 123),
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+		},
+		"null": {
+			input:    NewTupleNull(testTupleElemTypes),
+			expected: tftypes.NewValue(testTupleTerraformType, nil),
+		},
+		"unknown": {
+			input:    NewTupleUnknown(testTupleElemTypes),
+			expected: tftypes.NewValue(testTupleTerraformType, tftypes.UnknownValue),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.input.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected result (-got, +expected): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTupleValueRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]TupleValue{
+		"value": NewTupleValueMust(
+			testTupleElemTypes,
+			[]attr.Value{
+				NewStringValue("hello"),
+				NewInt64Value(123),
+				NewBoolValue(true),
+			},
+		),
+		"value-null-and-unknown-elements": NewTupleValueMust(
+			testTupleElemTypes,
+			[]attr.Value{
+				NewStringNull(),
+				NewInt64Unknown(),
+				NewBoolValue(false),
+			},
+		),
+		"null":    NewTupleNull(testTupleElemTypes),
+		"unknown": NewTupleUnknown(testTupleElemTypes),
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			tfValue, err := testCase.ToTerraformValue(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := testCase.Type(ctx).ValueFromTerraform(ctx, tfValue)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase) {
+				t.Errorf("expected %s, got %s", testCase, got)
+			}
+		})
+	}
+}
+
+func TestTupleValueEqual(t *testing.T) {
+	t.Parallel()
+
+	value := NewTupleValueMust(
+		testTupleElemTypes,
+		[]attr.Value{
+			NewStringValue("hello"),
+			NewInt64Value(123),
+			NewBoolValue(true),
+		},
+	)
+
+	testCases := map[string]struct {
+		receiver TupleValue
+		input    attr.Value
+		expected bool
+	}{
+		"equal": {
+			receiver: value,
+			input: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(123),
+					NewBoolValue(true),
+				},
+			),
+			expected: true,
+		},
+		"different-element": {
+			receiver: value,
+			input: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(456),
+					NewBoolValue(true),
+				},
+			),
+			expected: false,
+		},
+		"different-element-types": {
+			receiver: NewTupleNull([]attr.Type{StringType{}}),
+			input:    NewTupleNull([]attr.Type{BoolType{}}),
+			expected: false,
+		},
+		"known-null": {
+			receiver: value,
+			input:    NewTupleNull(testTupleElemTypes),
+			expected: false,
+		},
+		"null-null": {
+			receiver: NewTupleNull(testTupleElemTypes),
+			input:    NewTupleNull(testTupleElemTypes),
+			expected: true,
+		},
+		"unknown-unknown": {
+			receiver: NewTupleUnknown(testTupleElemTypes),
+			input:    NewTupleUnknown(testTupleElemTypes),
+			expected: true,
+		},
+		"different-type": {
+			receiver: value,
+			input:    NewStringValue("hello"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.receiver.Equal(testCase.input)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestTupleValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    TupleValue
+		expected string
+	}{
+		"value": {
+			input: NewTupleValueMust(
+				testTupleElemTypes,
+				[]attr.Value{
+					NewStringValue("hello"),
+					NewInt64Value(123),
+					NewBoolValue(true),
+				},
+			),
+			expected: `["hello",123,true]`,
+		},
+		"null": {
+			input:    NewTupleNull(testTupleElemTypes),
+			expected: "<null>",
+		},
+		"unknown": {
+			input:    NewTupleUnknown(testTupleElemTypes),
+			expected: "<unknown>",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.input.String()
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
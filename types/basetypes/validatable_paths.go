@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fwtype"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ typeWithValidatablePaths = ListType{}
+	_ typeWithValidatablePaths = MapType{}
+	_ typeWithValidatablePaths = ObjectType{}
+	_ typeWithValidatablePaths = SetType{}
+)
+
+// typeWithValidatablePaths is implemented by the collection and object types
+// which can report the paths validated within a value, so nested paths are
+// included.
+type typeWithValidatablePaths interface {
+	ValidatablePaths(context.Context, tftypes.Value) (path.Paths, diag.Diagnostics)
+}
+
+// elementValidatablePaths returns `elemPath` if `elemType` has validation,
+// followed by the paths validated within the element value, relative to the
+// parent value. No paths are returned if the element type has no validation,
+// as reported by hasValidation.
+func elementValidatablePaths(ctx context.Context, elemType attr.Type, elem tftypes.Value, elemPath path.Path) (path.Paths, diag.Diagnostics) {
+	if !hasValidation(elemType) {
+		return nil, nil
+	}
+
+	paths := path.Paths{elemPath}
+
+	nestedType, ok := elemType.(typeWithValidatablePaths)
+
+	if !ok {
+		return paths, nil
+	}
+
+	nestedPaths, diags := nestedType.ValidatablePaths(ctx, elem)
+
+	for _, nestedPath := range nestedPaths {
+		paths = append(paths, elemPath.Append(nestedPath))
+	}
+
+	return paths, diags
+}
+
+// hasValidation returns true if the given type implements any provider
+// defined validation which fwtype.Validate calls. An ObjectType without
+// RequiredTogether groups has no validation, even though it implements
+// xattr.TypeWithValidate, so collections of such objects skip converting and
+// validating their elements.
+func hasValidation(typ attr.Type) bool {
+	if objectType, ok := typ.(ObjectType); ok {
+		return len(objectType.RequiredTogether) > 0
+	}
+
+	return fwtype.HasValidation(typ)
+}
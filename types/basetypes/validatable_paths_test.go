@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidatablePaths(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ           typeWithValidatablePaths
+		in            tftypes.Value
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"list-validatable-elements": {
+			typ: ListType{ElemType: Int64Type{}},
+			in: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.Number},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+					tftypes.NewValue(tftypes.Number, 3),
+				},
+			),
+			expected: path.Paths{
+				path.Empty().AtListIndex(0),
+				path.Empty().AtListIndex(2),
+			},
+		},
+		"list-non-validatable-elements": {
+			typ: ListType{ElemType: StringType{}},
+			in: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				},
+			),
+			expected: nil,
+		},
+		"list-nested-validatable-elements": {
+			typ: ListType{ElemType: ListType{ElemType: Int64Type{}}},
+			in: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.List{ElementType: tftypes.Number}},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.List{ElementType: tftypes.Number},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.Number, 1),
+							tftypes.NewValue(tftypes.Number, 2),
+						},
+					),
+				},
+			),
+			expected: path.Paths{
+				path.Empty().AtListIndex(0),
+				path.Empty().AtListIndex(0).AtListIndex(0),
+				path.Empty().AtListIndex(0).AtListIndex(1),
+			},
+		},
+		"list-object-elements-without-required-together": {
+			typ: ListType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"host": StringType{},
+						"port": Int64Type{},
+					},
+				},
+			},
+			in: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"host": tftypes.String, "port": tftypes.Number}}},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Object{AttributeTypes: map[string]tftypes.Type{"host": tftypes.String, "port": tftypes.Number}},
+						map[string]tftypes.Value{
+							"host": tftypes.NewValue(tftypes.String, "example.com"),
+							"port": tftypes.NewValue(tftypes.Number, 443),
+						},
+					),
+				},
+			),
+			expected: nil,
+		},
+		"list-object-elements-with-required-together": {
+			typ: ListType{
+				ElemType: ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"host": StringType{},
+						"port": Int64Type{},
+					},
+					RequiredTogether: [][]string{{"host", "port"}},
+				},
+			},
+			in: tftypes.NewValue(
+				tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"host": tftypes.String, "port": tftypes.Number}}},
+				[]tftypes.Value{
+					tftypes.NewValue(
+						tftypes.Object{AttributeTypes: map[string]tftypes.Type{"host": tftypes.String, "port": tftypes.Number}},
+						map[string]tftypes.Value{
+							"host": tftypes.NewValue(tftypes.String, "example.com"),
+							"port": tftypes.NewValue(tftypes.Number, 443),
+						},
+					),
+				},
+			),
+			expected: path.Paths{
+				path.Empty().AtListIndex(0),
+				path.Empty().AtListIndex(0).AtName("port"),
+			},
+		},
+		"list-null": {
+			typ:      ListType{ElemType: Int64Type{}},
+			in:       tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, nil),
+			expected: nil,
+		},
+		"list-unknown": {
+			typ:      ListType{ElemType: Int64Type{}},
+			in:       tftypes.NewValue(tftypes.List{ElementType: tftypes.Number}, tftypes.UnknownValue),
+			expected: nil,
+		},
+		"map-validatable-elements": {
+			typ: MapType{ElemType: Int64Type{}},
+			in: tftypes.NewValue(
+				tftypes.Map{ElementType: tftypes.Number},
+				map[string]tftypes.Value{
+					"b": tftypes.NewValue(tftypes.Number, 2),
+					"a": tftypes.NewValue(tftypes.Number, 1),
+				},
+			),
+			expected: path.Paths{
+				path.Empty().AtMapKey("a"),
+				path.Empty().AtMapKey("b"),
+			},
+		},
+		"map-non-validatable-elements": {
+			typ: MapType{ElemType: StringType{}},
+			in: tftypes.NewValue(
+				tftypes.Map{ElementType: tftypes.String},
+				map[string]tftypes.Value{
+					"a": tftypes.NewValue(tftypes.String, "one"),
+				},
+			),
+			expected: nil,
+		},
+		"set-validatable-elements": {
+			typ: SetType{ElemType: Int64Type{}},
+			in: tftypes.NewValue(
+				tftypes.Set{ElementType: tftypes.Number},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.Number, 1),
+					tftypes.NewValue(tftypes.Number, 2),
+				},
+			),
+			expected: path.Paths{
+				path.Empty().AtSetValue(NewInt64Value(1)),
+				path.Empty().AtSetValue(NewInt64Value(2)),
+			},
+		},
+		"set-non-validatable-elements": {
+			typ: SetType{ElemType: StringType{}},
+			in: tftypes.NewValue(
+				tftypes.Set{ElementType: tftypes.String},
+				[]tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+				},
+			),
+			expected: nil,
+		},
+		"object-validatable-attributes": {
+			typ: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"count": Int64Type{},
+					"name":  StringType{},
+					"tags":  ListType{ElemType: StringType{}},
+				},
+			},
+			in: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"count": tftypes.Number,
+						"name":  tftypes.String,
+						"tags":  tftypes.List{ElementType: tftypes.String},
+					},
+				},
+				map[string]tftypes.Value{
+					"count": tftypes.NewValue(tftypes.Number, 1),
+					"name":  tftypes.NewValue(tftypes.String, "test"),
+					"tags": tftypes.NewValue(
+						tftypes.List{ElementType: tftypes.String},
+						[]tftypes.Value{
+							tftypes.NewValue(tftypes.String, "one"),
+						},
+					),
+				},
+			),
+			expected: path.Paths{
+				path.Root("count"),
+				path.Root("tags"),
+			},
+		},
+		"object-non-validatable-attributes": {
+			typ: ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": StringType{},
+				},
+			},
+			in: tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				},
+				map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				},
+			),
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := testCase.typ.ValidatablePaths(context.Background(), testCase.in)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
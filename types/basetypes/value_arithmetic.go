@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// arithmeticNullDiagnostics returns an error diagnostic if either operand
+// state is null. The `typeName`, such as "Int64", and `operation`, such as
+// "add", are used in diagnostics.
+func arithmeticNullDiagnostics(typeName string, operation string, a, b attr.ValueState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if a != attr.ValueStateNull && b != attr.ValueStateNull {
+		return diags
+	}
+
+	diags.AddError(
+		fmt.Sprintf("Null %s Value", typeName),
+		fmt.Sprintf("An unexpected error was encountered trying to %s %s values. This is always an error in the provider. Please report the following to the provider developer:\n\n", operation, typeName)+
+			fmt.Sprintf("Cannot %s a null value.", operation),
+	)
+
+	return diags
+}
+
+// panicOnArithmeticDiagnostics panics if the diagnostics contain an error.
+// The `method` is the name of the calling method, such as "AddMust".
+func panicOnArithmeticDiagnostics(method string, diags diag.Diagnostics) {
+	if !diags.HasError() {
+		return
+	}
+
+	// This could potentially be added to the diag package.
+	diagsStrings := make([]string, 0, len(diags))
+
+	for _, diagnostic := range diags {
+		diagsStrings = append(diagsStrings, fmt.Sprintf(
+			"%s | %s | %s",
+			diagnostic.Severity(),
+			diagnostic.Summary(),
+			diagnostic.Detail()))
+	}
+
+	panic(method + " received error(s): " + strings.Join(diagsStrings, "\n"))
+}
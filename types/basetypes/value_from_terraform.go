@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ValueFromTerraformAuto returns a base type value given a tftypes.Value,
+// inferring the base type from the Terraform type of the value, such as
+// StringType for tftypes.String, for callers which do not know the
+// attr.Type to use with ValueFromTerraform. Numbers are always NumberValue.
+// Element and attribute types of collections, objects, and tuples are
+// inferred recursively. Values of tftypes.DynamicPseudoType are returned as
+// DynamicValue.
+//
+// An error is returned if the value has no Terraform type, or if the
+// Terraform type has no associated base type.
+func ValueFromTerraformAuto(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	if in.Type() == nil {
+		return nil, errors.New("cannot infer the type of a value without a Terraform type")
+	}
+
+	typ, err := dynamicUnderlyingType(in.Type())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return typ.ValueFromTerraform(ctx, in)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValueFromTerraformAuto(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"tags": tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		input         tftypes.Value
+		expected      attr.Value
+		expectedError string
+	}{
+		"bool": {
+			input:    tftypes.NewValue(tftypes.Bool, true),
+			expected: NewBoolValue(true),
+		},
+		"number": {
+			input:    tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			expected: NewNumberValue(big.NewFloat(1.5)),
+		},
+		"string": {
+			input:    tftypes.NewValue(tftypes.String, "test"),
+			expected: NewStringValue("test"),
+		},
+		"string-null": {
+			input:    tftypes.NewValue(tftypes.String, nil),
+			expected: NewStringNull(),
+		},
+		"string-unknown": {
+			input:    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: NewStringUnknown(),
+		},
+		"set": {
+			input: tftypes.NewValue(tftypes.Set{ElementType: tftypes.Bool}, []tftypes.Value{
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+			expected: NewSetValueMust(BoolType{}, []attr.Value{
+				NewBoolValue(true),
+			}),
+		},
+		"tuple": {
+			input: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "test"),
+				tftypes.NewValue(tftypes.Bool, false),
+			}),
+			expected: NewTupleValueMust(
+				[]attr.Type{StringType{}, BoolType{}},
+				[]attr.Value{NewStringValue("test"), NewBoolValue(false)},
+			),
+		},
+		"list-of-objects": {
+			input: tftypes.NewValue(tftypes.List{ElementType: objectType}, []tftypes.Value{
+				tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "one"),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"env": tftypes.NewValue(tftypes.String, "test"),
+					}),
+				}),
+				tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "two"),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+			}),
+			expected: NewListValueMust(
+				ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"name": StringType{},
+						"tags": MapType{ElemType: StringType{}},
+					},
+				},
+				[]attr.Value{
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"name": StringType{},
+							"tags": MapType{ElemType: StringType{}},
+						},
+						map[string]attr.Value{
+							"name": NewStringValue("one"),
+							"tags": NewMapValueMust(StringType{}, map[string]attr.Value{
+								"env": NewStringValue("test"),
+							}),
+						},
+					),
+					NewObjectValueMust(
+						map[string]attr.Type{
+							"name": StringType{},
+							"tags": MapType{ElemType: StringType{}},
+						},
+						map[string]attr.Value{
+							"name": NewStringValue("two"),
+							"tags": NewMapNull(StringType{}),
+						},
+					),
+				},
+			),
+		},
+		"dynamic-unknown": {
+			input:    tftypes.NewValue(tftypes.DynamicPseudoType, tftypes.UnknownValue),
+			expected: NewDynamicUnknown(),
+		},
+		"no-type": {
+			input:         tftypes.Value{},
+			expectedError: "cannot infer the type of a value without a Terraform type",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ValueFromTerraformAuto(context.Background(), testCase.input)
+
+			if err != nil {
+				if testCase.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), testCase.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if testCase.expectedError != "" {
+				t.Fatalf("expected error %q, got none", testCase.expectedError)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package basetypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// valueFromTypable returns the given value converted to the given type with
+// the ValueFrom method of its Typable interface, such as the ValueFromString
+// method of StringTypable, when the value implements the matching Valuable
+// interface. An error diagnostic is returned if the value cannot be
+// converted, or if the converted value type does not equal the given type.
+func valueFromTypable(ctx context.Context, typ attr.Type, v attr.Value) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var result attr.Value
+
+	switch t := typ.(type) {
+	case BoolTypable:
+		valuable, ok := v.(BoolValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToBoolValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromBool(ctx, value)
+		diags.Append(valueDiags...)
+	case DynamicTypable:
+		valuable, ok := v.(DynamicValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToDynamicValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromDynamic(ctx, value)
+		diags.Append(valueDiags...)
+	case Float64Typable:
+		valuable, ok := v.(Float64Valuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToFloat64Value(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromFloat64(ctx, value)
+		diags.Append(valueDiags...)
+	case Int64Typable:
+		valuable, ok := v.(Int64Valuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToInt64Value(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromInt64(ctx, value)
+		diags.Append(valueDiags...)
+	case ListTypable:
+		valuable, ok := v.(ListValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToListValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromList(ctx, value)
+		diags.Append(valueDiags...)
+	case MapTypable:
+		valuable, ok := v.(MapValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToMapValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromMap(ctx, value)
+		diags.Append(valueDiags...)
+	case NumberTypable:
+		valuable, ok := v.(NumberValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToNumberValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromNumber(ctx, value)
+		diags.Append(valueDiags...)
+	case ObjectTypable:
+		valuable, ok := v.(ObjectValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToObjectValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromObject(ctx, value)
+		diags.Append(valueDiags...)
+	case SetTypable:
+		valuable, ok := v.(SetValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToSetValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromSet(ctx, value)
+		diags.Append(valueDiags...)
+	case StringTypable:
+		valuable, ok := v.(StringValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToStringValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromString(ctx, value)
+		diags.Append(valueDiags...)
+	case TupleTypable:
+		valuable, ok := v.(TupleValuable)
+
+		if !ok {
+			break
+		}
+
+		value, valueDiags := valuable.ToTupleValue(ctx)
+		diags.Append(valueDiags...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		result, valueDiags = t.ValueFromTuple(ctx, value)
+		diags.Append(valueDiags...)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if result == nil || !result.Type(ctx).Equal(typ) {
+		diags.AddError(
+			"Value Conversion Error",
+			"An unexpected error was encountered trying to convert a value to its expected type. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected Type: %s\n", typ)+
+				fmt.Sprintf("Value Type: %s", v.Type(ctx)),
+		)
+
+		return nil, diags
+	}
+
+	return result, diags
+}